@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// LogBenchmark runs fn iterations times and logs its average cost at DEBUG level, with the benchmark_name,
+// iterations, ns_per_op, allocs_per_op and bytes_per_op fields. Allocations are measured with runtime.ReadMemStats,
+// so those of other goroutines running at the same time are included.
+func (l *FileLogger) LogBenchmark(name string, iterations int, fn func()) {
+	if iterations < 1 {
+		iterations = 1
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		fn()
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	n := uint64(iterations)
+	fields := map[string]interface{}{
+		"benchmark_name": name,
+		"iterations":     iterations,
+		"ns_per_op":      elapsed.Nanoseconds() / int64(iterations),
+		"allocs_per_op":  (after.Mallocs - before.Mallocs) / n,
+		"bytes_per_op":   (after.TotalAlloc - before.TotalAlloc) / n,
+	}
+	l.logAt(LogLevelDebug, fmt.Sprintf("benchmark %s", formatFields(fields)))
+}
@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogBenchmark(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	const opCost = time.Millisecond
+	logger.LogBenchmark("sleep", 5, func() { time.Sleep(opCost) })
+
+	content := readLogFile(t, logger)
+	fields := make(map[string]string)
+	for _, pair := range strings.Fields(content[strings.Index(content, "DEBUG benchmark "):]) {
+		if k, v, ok := strings.Cut(pair, "="); ok {
+			fields[k] = v
+		}
+	}
+
+	if fields["benchmark_name"] != "sleep" || fields["iterations"] != "5" {
+		t.Errorf("expected benchmark_name=sleep iterations=5; got %q", content)
+	}
+	for _, key := range []string{"allocs_per_op", "bytes_per_op"} {
+		if _, err := strconv.ParseUint(fields[key], 10, 64); err != nil {
+			t.Errorf("expected a numeric %s; got %q", key, content)
+		}
+	}
+
+	nsPerOp, err := strconv.ParseInt(fields["ns_per_op"], 10, 64)
+	if err != nil {
+		t.Fatalf("expected a numeric ns_per_op; got %q", content)
+	}
+	if nsPerOp < int64(opCost)/100 || nsPerOp > int64(opCost)*100 {
+		t.Errorf("expected ns_per_op within 2 orders of magnitude of %d; got %d", opCost.Nanoseconds(), nsPerOp)
+	}
+}
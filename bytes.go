@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const defaultMaxBytesLogged = 1024
+
+// WithMaxBytesLogged caps the number of bytes dumped by LogBytes; the default is 1024.
+func WithMaxBytesLogged(n int) Option {
+	return func(l *FileLogger) {
+		l.MaxBytesLogged = n
+	}
+}
+
+// LogBytes logs data as a hex dump with 16 bytes per line and their ASCII representation side by side.
+// Data longer than MaxBytesLogged is cut off and followed by a note with the total length.
+func (l *FileLogger) LogBytes(level LogLevel, label string, data []byte) {
+	l.logAt(level, formatBytes(label, data, l.MaxBytesLogged))
+}
+
+func formatBytes(label string, data []byte, maxBytes int) string {
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxBytesLogged
+	}
+
+	dumped := data
+	if len(dumped) > maxBytes {
+		dumped = dumped[:maxBytes]
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s length=%d", label, len(data))
+	if len(dumped) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSuffix(hex.Dump(dumped), "\n"))
+	}
+	if len(data) > maxBytes {
+		fmt.Fprintf(&sb, "\n[truncated: %d bytes total]", len(data))
+	}
+	return sb.String()
+}
@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// parseDump extracts the bytes from the hex columns of a dump produced by hex.Dump.
+func parseDump(t *testing.T, lines []string) []byte {
+	var data []byte
+	for _, line := range lines {
+		columns, _, _ := strings.Cut(line, "|")
+		fields := strings.Fields(columns)
+		for _, field := range fields[1:] {
+			b, err := hex.DecodeString(field)
+			if err != nil {
+				t.Fatalf("invalid hex %q in line %q: %s", field, line, err)
+			}
+			data = append(data, b...)
+		}
+	}
+	return data
+}
+
+func TestFormatBytes(t *testing.T) {
+	data := []byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n")
+
+	dump := formatBytes("request", data, 0)
+	lines := strings.Split(dump, "\n")
+
+	if lines[0] != fmt.Sprintf("request length=%d", len(data)) {
+		t.Errorf("expected a header with the label and length; got %q", lines[0])
+	}
+	if len(lines) != 4 {
+		t.Fatalf("expected 3 dump lines of 16 bytes; got %d", len(lines)-1)
+	}
+	if !strings.HasSuffix(lines[1], "|GET / HTTP/1.1..|") {
+		t.Errorf("expected the ASCII column next to the hex; got %q", lines[1])
+	}
+	if parsed := parseDump(t, lines[1:]); !bytes.Equal(parsed, data) {
+		t.Errorf("expected the dump to contain %x; got %x", data, parsed)
+	}
+}
+
+func TestFormatBytesTruncated(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      int
+		maxBytes  int
+		truncated bool
+		dumped    int
+	}{
+		{name: "below the default limit", size: 100, dumped: 100},
+		{name: "above the default limit", size: 2000, truncated: true, dumped: defaultMaxBytesLogged},
+		{name: "custom limit", size: 100, maxBytes: 32, truncated: true, dumped: 32},
+		{name: "exactly the limit", size: 32, maxBytes: 32, dumped: 32},
+		{name: "empty data", size: 0, dumped: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := bytes.Repeat([]byte{0xab}, tt.size)
+			lines := strings.Split(formatBytes("payload", data, tt.maxBytes), "\n")
+
+			last := lines[len(lines)-1]
+			note := fmt.Sprintf("[truncated: %d bytes total]", tt.size)
+			if tt.truncated != (last == note) {
+				t.Errorf("expected truncated %t; got last line %q", tt.truncated, last)
+			}
+			if tt.truncated {
+				lines = lines[:len(lines)-1]
+			}
+
+			if parsed := parseDump(t, lines[1:]); len(parsed) != tt.dumped {
+				t.Errorf("expected %d dumped bytes; got %d", tt.dumped, len(parsed))
+			}
+		})
+	}
+}
+
+func TestLogBytes(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.MaxBytesLogged = 16
+
+	logger.LogBytes(LogLevelDebug, "frame", []byte("0123456789abcdefXYZ"))
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "DEBUG frame length=19\n") {
+		t.Errorf("expected a debug header line; got %q", content)
+	}
+	if !strings.Contains(content, "|0123456789abcdef|\n[truncated: 19 bytes total]\n") {
+		t.Errorf("expected a truncated dump; got %q", content)
+	}
+}
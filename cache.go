@@ -0,0 +1,36 @@
+package logger
+
+import "fmt"
+
+// WithCacheStatsMinLevel sets the level at which LogCacheStats writes; the default is LogLevelDebug.
+func WithCacheStatsMinLevel(level LogLevel) Option {
+	return func(l *FileLogger) {
+		l.CacheStatsMinLevel = level
+	}
+}
+
+// LogCacheStats logs the counters of a cache as `cache_stats` with the cache_name, hits, misses, evictions
+// and hit_rate fields, followed by the extra fields. The hit rate is hits / (hits + misses), or 0 if there were no requests.
+func (l *FileLogger) LogCacheStats(name string, hits, misses, evictions int64, fields map[string]interface{}) {
+	l.logAt(l.CacheStatsMinLevel, formatCacheStats(name, hits, misses, evictions, fields))
+}
+
+func formatCacheStats(name string, hits, misses, evictions int64, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+5)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["cache_name"] = name
+	fields["hits"] = hits
+	fields["misses"] = misses
+	fields["evictions"] = evictions
+	fields["hit_rate"] = cacheHitRate(hits, misses)
+	return fmt.Sprintf("cache_stats %s", formatFields(fields))
+}
+
+func cacheHitRate(hits, misses int64) float64 {
+	if hits+misses <= 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
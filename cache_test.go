@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatCacheStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		hits      int64
+		misses    int64
+		evictions int64
+		extra     map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "hits and misses",
+			hits:      75,
+			misses:    25,
+			evictions: 3,
+			expected:  "cache_stats cache_name=users evictions=3 hit_rate=0.75 hits=75 misses=25",
+		},
+		{
+			name:     "zero hits",
+			misses:   10,
+			expected: "cache_stats cache_name=users evictions=0 hit_rate=0 hits=0 misses=10",
+		},
+		{
+			name:     "zero requests",
+			expected: "cache_stats cache_name=users evictions=0 hit_rate=0 hits=0 misses=0",
+		},
+		{
+			name:     "extra fields",
+			hits:     1,
+			extra:    map[string]interface{}{"region": "eu", "hits": 99},
+			expected: "cache_stats cache_name=users evictions=0 hit_rate=1 hits=1 misses=0 region=eu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatCacheStats("users", tt.hits, tt.misses, tt.evictions, tt.extra)
+			if got != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLogCacheStatsLevel(t *testing.T) {
+	captureConsole(t)
+
+	logger := newTestLogger(t)
+	logger.LogCacheStats("sessions", 1, 1, 0, nil)
+	if content := readLogFile(t, logger); !strings.Contains(content, "DEBUG cache_stats cache_name=sessions") {
+		t.Errorf("expected cache stats at DEBUG level; got %q", content)
+	}
+
+	logger = newTestLogger(t, WithCacheStatsMinLevel(LogLevelInfo), WithMinLevel(LogLevelInfo))
+	logger.LogCacheStats("sessions", 1, 1, 0, nil)
+	if content := readLogFile(t, logger); !strings.Contains(content, "INFO cache_stats cache_name=sessions") {
+		t.Errorf("expected cache stats at INFO level; got %q", content)
+	}
+}
@@ -0,0 +1,129 @@
+package logger
+
+import "fmt"
+
+// ChainLogger writes to a logger and passes every message that gets through its level filter on to the next logger,
+// e.g. to notify an alerting service only of the messages a FileLogger actually logs.
+type ChainLogger struct {
+	logger Logger
+	next   Logger
+}
+
+var _ Logger = (*ChainLogger)(nil)
+
+// levelFilter is implemented by the loggers whose level filter gates a chain.
+type levelFilter interface {
+	enabled(level LogLevel) bool
+}
+
+// Chain returns a logger that writes to l and, for the messages that pass the minimum level of l, to next.
+// Fields passed in maps, such as the extra fields of LogChange, reach next unchanged.
+func (l *FileLogger) Chain(next Logger) *ChainLogger {
+	return &ChainLogger{logger: l, next: next}
+}
+
+// Chain appends next to the end of the chain, so that a.Chain(b).Chain(c) passes to c the messages that pass both
+// a and b. Loggers without a level filter, such as FieldLogger, pass every message on.
+func (c *ChainLogger) Chain(next Logger) *ChainLogger {
+	if tail, ok := c.next.(*ChainLogger); ok {
+		return &ChainLogger{logger: c.logger, next: tail.Chain(next)}
+	}
+	return &ChainLogger{logger: c.logger, next: &ChainLogger{logger: c.next, next: next}}
+}
+
+func (c *ChainLogger) enabled(level LogLevel) bool {
+	if f, ok := c.logger.(levelFilter); ok {
+		return f.enabled(level)
+	}
+	return true
+}
+
+// LogFatal logs err on the next logger first, since loggers such as FileLogger terminate the program on fatal errors.
+func (c *ChainLogger) LogFatal(err error) {
+	c.next.LogFatal(err)
+	c.logger.LogFatal(err)
+}
+
+func (c *ChainLogger) LogError(err error) {
+	c.logger.LogError(err)
+	level := LogLevelError
+	if l, ok := c.logger.(*FileLogger); ok {
+		level = l.errorLevel(err)
+	}
+	if c.enabled(level) {
+		c.next.LogError(err)
+	}
+}
+
+func (c *ChainLogger) LogWarn(message string) {
+	c.logger.LogWarn(message)
+	if c.enabled(LogLevelWarn) {
+		c.next.LogWarn(message)
+	}
+}
+
+func (c *ChainLogger) LogInfo(message string) {
+	c.logger.LogInfo(message)
+	if c.enabled(LogLevelInfo) {
+		c.next.LogInfo(message)
+	}
+}
+
+func (c *ChainLogger) LogDebug(message string) {
+	c.logger.LogDebug(message)
+	if c.enabled(LogLevelDebug) {
+		c.next.LogDebug(message)
+	}
+}
+
+func (c *ChainLogger) LogTrace(message string) {
+	c.logger.LogTrace(message)
+	if c.enabled(LogLevelTrace) {
+		c.next.LogTrace(message)
+	}
+}
+
+func (c *ChainLogger) LogBytes(level LogLevel, label string, data []byte) {
+	c.logger.LogBytes(level, label, data)
+	if c.enabled(level) {
+		c.next.LogBytes(level, label, data)
+	}
+}
+
+func (c *ChainLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	c.logger.LogChange(field, from, to, extra)
+	level := LogLevelInfo
+	if l, ok := c.logger.(*FileLogger); ok {
+		level = l.ChangeMinLevel
+	}
+	if c.enabled(level) {
+		c.next.LogChange(field, from, to, extra)
+	}
+}
+
+func (c *ChainLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	c.logger.LogDiff(level, label, before, after)
+	if c.enabled(level) {
+		c.next.LogDiff(level, label, before, after)
+	}
+}
+
+func (c *ChainLogger) LogFatalf(format string, args ...interface{}) {
+	c.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (c *ChainLogger) LogErrorf(format string, args ...interface{}) {
+	c.LogError(fmt.Errorf(format, args...))
+}
+
+func (c *ChainLogger) LogWarnf(format string, args ...interface{}) {
+	c.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (c *ChainLogger) LogInfof(format string, args ...interface{}) {
+	c.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (c *ChainLogger) LogDebugf(format string, args ...interface{}) {
+	c.LogDebug(fmt.Sprintf(format, args...))
+}
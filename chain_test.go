@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name     string
+		minLevel LogLevel
+		expected []string
+	}{
+		{
+			name:     "debug filtered",
+			minLevel: LogLevelInfo,
+			expected: []string{"INFO info message", "ERROR error message"},
+		},
+		{
+			name:     "debug allowed",
+			minLevel: LogLevelDebug,
+			expected: []string{"DEBUG debug message", "INFO info message", "ERROR error message"},
+		},
+		{
+			name:     "errors only",
+			minLevel: LogLevelError,
+			expected: []string{"ERROR error message"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t)
+			logger.SetMinLevel(tt.minLevel)
+			next := &recordingLogger{}
+
+			chain := logger.Chain(next)
+			chain.LogDebug("debug message")
+			chain.LogInfo("info message")
+			chain.LogError(errors.New("error message"))
+
+			if !reflect.DeepEqual(next.messages, tt.expected) {
+				t.Errorf("expected %v; got %v", tt.expected, next.messages)
+			}
+		})
+	}
+}
+
+func TestChainThreeLoggers(t *testing.T) {
+	captureConsole(t)
+	first := newTestLogger(t)
+	first.SetMinLevel(LogLevelDebug)
+	second := newTestLogger(t)
+	second.SetMinLevel(LogLevelWarn)
+	last := &recordingLogger{}
+
+	chain := first.Chain(second).Chain(last)
+	chain.LogDebug("debug message")
+	chain.LogInfo("info message")
+	chain.LogWarn("warn message")
+	chain.LogChange("plan", "free", "pro", map[string]interface{}{"user_id": 7})
+
+	firstLog := readLogFile(t, first)
+	for _, message := range []string{"DEBUG debug message", "INFO info message", "WARNING warn message", "INFO CHANGE plan"} {
+		if !strings.Contains(firstLog, message) {
+			t.Errorf("expected %q in the first log; got %q", message, firstLog)
+		}
+	}
+	secondLog := readLogFile(t, second)
+	if strings.Contains(secondLog, "DEBUG") || strings.Contains(secondLog, "INFO") || !strings.Contains(secondLog, "WARNING warn message") {
+		t.Errorf("expected only the warn message in the second log; got %q", secondLog)
+	}
+
+	expected := []string{"WARNING warn message"}
+	if !reflect.DeepEqual(last.messages, expected) {
+		t.Errorf("expected %v; got %v", expected, last.messages)
+	}
+
+	second.SetMinLevel(LogLevelInfo)
+	chain.LogChange("plan", "free", "pro", map[string]interface{}{"user_id": 7})
+	expected = append(expected, "INFO CHANGE plan: free -> pro user_id=7")
+	if !reflect.DeepEqual(last.messages, expected) {
+		t.Errorf("expected %v; got %v", expected, last.messages)
+	}
+}
@@ -0,0 +1,24 @@
+package logger
+
+import "fmt"
+
+// WithChangeMinLevel sets the level at which LogChange writes; the default is LogLevelInfo.
+func WithChangeMinLevel(level LogLevel) Option {
+	return func(l *FileLogger) {
+		l.ChangeMinLevel = level
+	}
+}
+
+// LogChange logs that the value of field changed from one value to another, as `CHANGE <field>: <from> -> <to> [extra]`.
+// The extra fields are appended as key=value pairs.
+func (l *FileLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	l.logAt(l.ChangeMinLevel, formatChange(field, from, to, extra))
+}
+
+func formatChange(field string, from, to interface{}, extra map[string]interface{}) string {
+	message := fmt.Sprintf("CHANGE %s: %v -> %v", field, from, to)
+	if len(extra) > 0 {
+		message = fmt.Sprintf("%s %s", message, formatFields(extra))
+	}
+	return message
+}
@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		from     interface{}
+		to       interface{}
+		extra    map[string]interface{}
+		expected string
+	}{
+		{name: "string", field: "region", from: "eu", to: "us", expected: "CHANGE region: eu -> us"},
+		{name: "int", field: "workers", from: 4, to: 8, expected: "CHANGE workers: 4 -> 8"},
+		{name: "bool", field: "debug", from: false, to: true, expected: "CHANGE debug: false -> true"},
+		{name: "nil", field: "proxy", from: nil, to: "http://proxy", expected: "CHANGE proxy: <nil> -> http://proxy"},
+		{
+			name:     "extra fields",
+			field:    "workers",
+			from:     4,
+			to:       8,
+			extra:    map[string]interface{}{"source": "api", "actor": "admin"},
+			expected: "CHANGE workers: 4 -> 8 actor=admin source=api",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatChange(tt.field, tt.from, tt.to, tt.extra)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogChange(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{name: "default level", expected: "INFO CHANGE workers: 4 -> 8\n"},
+		{name: "custom level", opts: []Option{WithChangeMinLevel(LogLevelWarn)}, expected: "WARNING CHANGE workers: 4 -> 8\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, tt.opts...)
+			logger.LogChange("workers", 4, 8, nil)
+
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected line ending with %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+const checksumSeparator = " checksum="
+
+var checksumAlgorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// WithChecksum appends a checksum=<hex> field to every entry written to the log files, computed over the entry
+// including its timestamp. The algorithm is one of md5, sha1, sha256 or sha512; an empty algorithm means sha256.
+// Use VerifyFile to check the entries of a log file.
+func WithChecksum(algorithm string) Option {
+	return func(l *FileLogger) {
+		l.WriteChecksum = true
+		l.ChecksumAlgorithm = algorithm
+	}
+}
+
+type checksumWriter struct {
+	w       io.Writer
+	newHash func() hash.Hash
+}
+
+func newChecksumWriter(w io.Writer, algorithm string) (io.Writer, error) {
+	newHash, err := checksumAlgorithm(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &checksumWriter{w: w, newHash: newHash}, nil
+}
+
+func (c *checksumWriter) Write(p []byte) (int, error) {
+	entry := bytes.TrimSuffix(p, []byte("\n"))
+
+	line := make([]byte, 0, len(entry)+len(checksumSeparator)+2*c.newHash().Size()+1)
+	line = append(line, entry...)
+	line = append(line, checksumSeparator...)
+	line = append(line, checksum(c.newHash, entry)...)
+	line = append(line, '\n')
+
+	if _, err := c.w.Write(line); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// VerifyFile checks the checksums of a log file written with WithChecksum and returns the line numbers,
+// starting at 1, of the entries whose checksum does not match. Entries spanning several lines are reported
+// by the number of their last line, and trailing lines without a checksum by the number of their first line.
+func VerifyFile(path string, algorithm string) ([]int, error) {
+	newHash, err := checksumAlgorithm(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var mismatched []int
+	var entry []string
+	entryStart := 0
+	lineNum := 0
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if len(entry) == 0 {
+			entryStart = lineNum
+		}
+
+		i := strings.LastIndex(line, checksumSeparator)
+		if i < 0 {
+			entry = append(entry, line)
+			continue
+		}
+
+		entry = append(entry, line[:i])
+		if checksum(newHash, []byte(strings.Join(entry, "\n"))) != line[i+len(checksumSeparator):] {
+			mismatched = append(mismatched, lineNum)
+		}
+		entry = entry[:0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(entry) > 0 {
+		mismatched = append(mismatched, entryStart)
+	}
+	return mismatched, nil
+}
+
+func checksumAlgorithm(algorithm string) (func() hash.Hash, error) {
+	if algorithm == "" {
+		algorithm = "sha256"
+	}
+	newHash, ok := checksumAlgorithms[strings.ToLower(algorithm)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+	return newHash, nil
+}
+
+func checksum(newHash func() hash.Hash, data []byte) string {
+	h := newHash()
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
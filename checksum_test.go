@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyFile(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithChecksum(""))
+
+	for i := 1; i <= 100; i++ {
+		logger.LogInfo(fmt.Sprintf("line %d", i))
+	}
+
+	path := logger.CurrentLogFile.Name()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	lines := strings.Split(string(content), "\n")
+	lines[41] = strings.Replace(lines[41], "line 42", "line 24", 1)
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0666); err != nil {
+		t.Fatalf("failed to corrupt log file: %s", err)
+	}
+
+	mismatched, err := VerifyFile(path, "sha256")
+	if err != nil {
+		t.Fatalf("failed to verify log file: %s", err)
+	}
+	if len(mismatched) != 1 || mismatched[0] != 42 {
+		t.Errorf("expected only line 42 to mismatch; got %v", mismatched)
+	}
+}
+
+func TestVerifyFileAlgorithms(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		algorithm string
+		wantErr   bool
+	}{
+		{algorithm: "md5"},
+		{algorithm: "sha1"},
+		{algorithm: "SHA256"},
+		{algorithm: "sha512"},
+		{algorithm: "crc32", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			logger, err := newLogger(false, t.TempDir(), WithChecksum(tt.algorithm))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %t; got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer logger.Close()
+
+			logger.LogInfo("single line")
+			logger.LogBytes(LogLevelInfo, "payload", []byte("spans several lines of hex dump output"))
+
+			mismatched, err := VerifyFile(logger.CurrentLogFile.Name(), tt.algorithm)
+			if err != nil {
+				t.Fatalf("failed to verify log file: %s", err)
+			}
+			if len(mismatched) != 0 {
+				t.Errorf("expected no mismatched lines; got %v", mismatched)
+			}
+		})
+	}
+}
+
+func TestVerifyFileMissingChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plain.log")
+	if err := os.WriteFile(path, []byte("2025/01/02 15:04:05 INFO no checksum\n"), 0666); err != nil {
+		t.Fatalf("failed to write log file: %s", err)
+	}
+
+	mismatched, err := VerifyFile(path, "sha256")
+	if err != nil {
+		t.Fatalf("failed to verify log file: %s", err)
+	}
+	if len(mismatched) != 1 || mismatched[0] != 1 {
+		t.Errorf("expected line 1 to mismatch; got %v", mismatched)
+	}
+}
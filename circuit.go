@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Circuit breaker states, as passed to LogCircuitBreaker.
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
+
+// LogCircuitBreaker logs a state transition of the circuit breaker name as `circuit_breaker` with the name, state,
+// previous_state, failure_count and cooldown_ms fields, followed by the extra fields.
+// Transitions to the open state are logged at WARN level and all others at INFO level.
+func (l *FileLogger) LogCircuitBreaker(name, state, prevState string, failureCount int, cooldown time.Duration, fields map[string]interface{}) {
+	l.logAt(circuitBreakerLevel(state), formatCircuitBreaker(name, state, prevState, failureCount, cooldown, fields))
+}
+
+// CBLogger wraps a Logger to log circuit breaker transitions, remembering the last state of every breaker.
+type CBLogger struct {
+	Logger
+	mu     sync.Mutex
+	states map[string]string
+}
+
+// NewCBLogger returns a CBLogger that writes through base. Breakers start in the closed state.
+func NewCBLogger(base Logger) *CBLogger {
+	return &CBLogger{Logger: base, states: make(map[string]string)}
+}
+
+// SetState records that the breaker name moved to state and logs the transition like FileLogger.LogCircuitBreaker,
+// with the last recorded state as the previous one. Nothing is logged if the state did not change.
+func (c *CBLogger) SetState(name, state string, failureCount int, cooldown time.Duration, fields map[string]interface{}) {
+	c.mu.Lock()
+	prevState := c.state(name)
+	c.states[name] = state
+	c.mu.Unlock()
+
+	if state == prevState {
+		return
+	}
+	message := formatCircuitBreaker(name, state, prevState, failureCount, cooldown, fields)
+	if circuitBreakerLevel(state) == LogLevelWarn {
+		c.Logger.LogWarn(message)
+		return
+	}
+	c.Logger.LogInfo(message)
+}
+
+// State returns the last recorded state of the breaker name.
+func (c *CBLogger) State(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.state(name)
+}
+
+func (c *CBLogger) state(name string) string {
+	if state, ok := c.states[name]; ok {
+		return state
+	}
+	return CircuitClosed
+}
+
+func circuitBreakerLevel(state string) LogLevel {
+	if state == CircuitOpen {
+		return LogLevelWarn
+	}
+	return LogLevelInfo
+}
+
+func formatCircuitBreaker(name, state, prevState string, failureCount int, cooldown time.Duration, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+5)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["name"] = name
+	fields["state"] = state
+	fields["previous_state"] = prevState
+	fields["failure_count"] = failureCount
+	fields["cooldown_ms"] = cooldown.Milliseconds()
+	return fmt.Sprintf("circuit_breaker %s", formatFields(fields))
+}
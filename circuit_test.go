@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     string
+		prevState string
+		expected  string
+	}{
+		{
+			name:      "closed to open",
+			state:     CircuitOpen,
+			prevState: CircuitClosed,
+			expected:  "WARNING circuit_breaker cooldown_ms=30000 failure_count=5 name=payments previous_state=closed state=open",
+		},
+		{
+			name:      "open to half-open",
+			state:     CircuitHalfOpen,
+			prevState: CircuitOpen,
+			expected:  "INFO circuit_breaker cooldown_ms=30000 failure_count=5 name=payments previous_state=open state=half-open",
+		},
+		{
+			name:      "half-open to closed",
+			state:     CircuitClosed,
+			prevState: CircuitHalfOpen,
+			expected:  "INFO circuit_breaker cooldown_ms=30000 failure_count=5 name=payments previous_state=half-open state=closed",
+		},
+		{
+			name:      "half-open to open",
+			state:     CircuitOpen,
+			prevState: CircuitHalfOpen,
+			expected:  "WARNING circuit_breaker cooldown_ms=30000 failure_count=5 name=payments previous_state=half-open state=open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogCircuitBreaker("payments", tt.state, tt.prevState, 5, 30*time.Second, nil)
+
+			if content := readLogFile(t, logger); !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestCBLogger(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	cb := NewCBLogger(logger)
+
+	if state := cb.State("payments"); state != CircuitClosed {
+		t.Errorf("expected breakers to start closed; got %s", state)
+	}
+
+	cb.SetState("payments", CircuitOpen, 5, time.Minute, map[string]interface{}{"region": "eu"})
+	cb.SetState("payments", CircuitOpen, 6, time.Minute, nil)
+	cb.SetState("payments", CircuitHalfOpen, 6, 0, nil)
+	cb.SetState("payments", CircuitClosed, 0, 0, nil)
+	cb.SetState("search", CircuitOpen, 3, time.Second, nil)
+
+	if state := cb.State("search"); state != CircuitOpen {
+		t.Errorf("expected search to be open; got %s", state)
+	}
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	expected := []string{
+		"WARNING circuit_breaker cooldown_ms=60000 failure_count=5 name=payments previous_state=closed region=eu state=open",
+		"INFO circuit_breaker cooldown_ms=0 failure_count=6 name=payments previous_state=open state=half-open",
+		"INFO circuit_breaker cooldown_ms=0 failure_count=0 name=payments previous_state=half-open state=closed",
+		"WARNING circuit_breaker cooldown_ms=1000 failure_count=3 name=search previous_state=closed state=open",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d transitions; got %q", len(expected), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("expected line %d to be %q; got %q", i, expected[i], line)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Clone returns a new FileLogger with the same settings and minimum level that shares no state with l.
+// The clone writes to a new log file in the same directory, with the next sequence number, and runs its own
+// watchdog if one is configured. Named outputs, context extractors, error level rules and the environment level watch
+// are not copied, and its ring buffer, if any, starts empty. Tags are copied.
+func (l *FileLogger) Clone() (*FileLogger, error) {
+	c := l.cloneSettings()
+	if l.ring != nil {
+		c.ring = &ringBuffer{buf: make([]byte, len(l.ring.buf))}
+	}
+
+	logFile, err := getNextLogFile(c.LogDir, c.SeqPadding, c.Rotation, c.filenamePID())
+	if err != nil {
+		return nil, fmt.Errorf("failed getting log file: %w", err)
+	}
+	if err = c.setLogFile(logFile); err != nil {
+		logFile.Close()
+		return nil, fmt.Errorf("failed setting up log file: %w", err)
+	}
+	c.dirLock = registerLogDir(c.LogDir)
+
+	if c.WatchdogInterval > 0 && c.WatchdogFn != nil {
+		c.startWatchdog()
+	}
+	if c.CompressRotated {
+		c.startCompressWorker()
+	}
+
+	return c, nil
+}
+
+// cloneSettings returns a FileLogger with the settings, minimum level and tags of l, without a log file.
+func (l *FileLogger) cloneSettings() *FileLogger {
+	c := &FileLogger{
+		DevMode:                    l.DevMode,
+		LogDir:                     l.LogDir,
+		EncryptionKey:              slices.Clone(l.EncryptionKey),
+		ColorScheme:                l.ColorScheme,
+		OnAfterRotate:              l.OnAfterRotate,
+		RedactConfigKeys:           slices.Clone(l.RedactConfigKeys),
+		WatchdogInterval:           l.WatchdogInterval,
+		WatchdogFn:                 l.WatchdogFn,
+		MaxBytesLogged:             l.MaxBytesLogged,
+		ChangeMinLevel:             l.ChangeMinLevel,
+		SeqPadding:                 l.SeqPadding,
+		Rotation:                   l.Rotation,
+		IncludePIDInFilename:       l.IncludePIDInFilename,
+		KubernetesMode:             l.KubernetesMode,
+		MaxLinesPerFile:            l.MaxLinesPerFile,
+		MaxFileSizeBytes:           l.MaxFileSizeBytes,
+		ErrorResponseTemplate:      l.ErrorResponseTemplate,
+		HTTPErrorTemplate:          l.HTTPErrorTemplate,
+		WriteChecksum:              l.WriteChecksum,
+		ChecksumAlgorithm:          l.ChecksumAlgorithm,
+		TextEncoding:               l.TextEncoding,
+		DeduplicateAcrossProcesses: l.DeduplicateAcrossProcesses,
+		BloomFalsePositiveRate:     l.BloomFalsePositiveRate,
+		EmergencyFn:                l.EmergencyFn,
+		MaxMultilineLines:          l.MaxMultilineLines,
+		IncludeHostname:            l.IncludeHostname,
+		CacheStatsMinLevel:         l.CacheStatsMinLevel,
+		SlowQueryThreshold:         l.SlowQueryThreshold,
+		LogQueryParams:             l.LogQueryParams,
+		MaxQueryParamLen:           l.MaxQueryParamLen,
+		NetworkLogMinLevel:         l.NetworkLogMinLevel,
+		RateLimitMinLevel:          l.RateLimitMinLevel,
+		ConnectionLogMinLevel:      l.ConnectionLogMinLevel,
+		MemStatsKeys:               slices.Clone(l.MemStatsKeys),
+		FileOpThresholdMs:          l.FileOpThresholdMs,
+		ConfigChangeHook:           l.ConfigChangeHook,
+		SlowOperationHook:          l.SlowOperationHook,
+		MaxHTTPBodyLogBytes:        l.MaxHTTPBodyLogBytes,
+		CompressRotated:            l.CompressRotated,
+		CompressAfter:              l.CompressAfter,
+		SecurityAuditFile:          l.SecurityAuditFile,
+	}
+	c.minLevel.Store(l.minLevel.Load())
+	c.devMode.Store(l.IsDevMode())
+	c.DevMode = l.IsDevMode()
+	c.prefix = l.Prefix()
+	c.hostname = l.hostname
+	c.pid = l.pid
+	c.tags = l.Tags()
+	c.prodMode = l.productionMode()
+	return c
+}
@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithSeqPadding(2), WithRedactConfigKeys("password"))
+
+	clone, err := logger.Clone()
+	if err != nil {
+		t.Fatalf("failed to clone logger: %s", err)
+	}
+	defer clone.Close()
+
+	clone.SetMinLevel(LogLevelWarn)
+	clone.RedactConfigKeys[0] = "token"
+
+	logger.LogInfo("original info")
+	clone.LogInfo("clone info")
+	clone.LogWarn("clone warning")
+
+	if logger.MinLevel() != LogLevelDebug {
+		t.Errorf("expected the original min level to be unchanged; got %s", logger.MinLevel())
+	}
+	if logger.RedactConfigKeys[0] != "password" {
+		t.Errorf("expected the original redacted keys to be unchanged; got %v", logger.RedactConfigKeys)
+	}
+	if clone.SeqPadding != 2 {
+		t.Errorf("expected the clone to keep the settings; got seq padding %d", clone.SeqPadding)
+	}
+
+	originalName := filepath.Base(logger.CurrentLogFile.Name())
+	cloneName := filepath.Base(clone.CurrentLogFile.Name())
+	if !strings.HasSuffix(originalName, "_01.log") || !strings.HasSuffix(cloneName, "_02.log") {
+		t.Errorf("expected the clone to use the next log file; got %s and %s", originalName, cloneName)
+	}
+
+	original := readLogFile(t, logger)
+	if !strings.Contains(original, "INFO original info") || strings.Contains(original, "clone") {
+		t.Errorf("expected only the original's output in its file; got %q", original)
+	}
+	cloned := readLogFile(t, clone)
+	if strings.Contains(cloned, "clone info") || !strings.Contains(cloned, "WARNING clone warning") || strings.Contains(cloned, "original") {
+		t.Errorf("expected only the clone's output above its min level in its file; got %q", cloned)
+	}
+}
@@ -0,0 +1,26 @@
+package logger
+
+import "fmt"
+
+// LogCodedError logs err at ERROR level with its error code, as `ERROR [<code>] <message>`.
+// A nil err logs the code alone.
+func (l *FileLogger) LogCodedError(code int, err error) {
+	l.LogCodedErrorWith(code, err, nil)
+}
+
+// LogCodedErrorWith logs err with its error code like LogCodedError and appends fields,
+// e.g. built with NewFields, as key=value pairs.
+func (l *FileLogger) LogCodedErrorWith(code int, err error, fields map[string]interface{}) {
+	l.logAt(LogLevelError, formatCodedError(code, err, fields))
+}
+
+func formatCodedError(code int, err error, fields map[string]interface{}) string {
+	message := fmt.Sprintf("[%d]", code)
+	if err != nil {
+		message = fmt.Sprintf("%s %s", message, err.Error())
+	}
+	if len(fields) > 0 {
+		message = fmt.Sprintf("%s %s", message, formatFields(fields))
+	}
+	return message
+}
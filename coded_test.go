@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFormatCodedError(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		err      error
+		fields   map[string]interface{}
+		expected string
+	}{
+		{name: "code and error", code: 404, err: errors.New("user not found"), expected: "[404] user not found"},
+		{name: "zero code", code: 0, err: errors.New("unknown"), expected: "[0] unknown"},
+		{name: "negative code", code: -32600, err: errors.New("invalid request"), expected: "[-32600] invalid request"},
+		{name: "nil error", code: 500, expected: "[500]"},
+		{
+			name:     "fields",
+			code:     409,
+			err:      errors.New("conflict"),
+			fields:   map[string]interface{}{"resource": "order", "id": 7},
+			expected: "[409] conflict id=7 resource=order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatCodedError(tt.code, tt.err, tt.fields)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogCodedError(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogCodedError(503, errors.New("upstream unavailable"))
+	logger.LogCodedErrorWith(400, nil, map[string]interface{}{"field": "email"})
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "ERROR [503] upstream unavailable\n") {
+		t.Errorf("expected the coded error line; got %q", content)
+	}
+	if !strings.Contains(content, "ERROR [400] field=email\n") {
+		t.Errorf("expected the code with fields and no message; got %q", content)
+	}
+}
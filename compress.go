@@ -0,0 +1,166 @@
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const compressEventsBuffer = 16
+
+// CompressEvent reports the result of a compression started by CompressCurrentFile.
+type CompressEvent struct {
+	// Path is the compressed file, the log file name with a .gz suffix.
+	Path string
+	// SizeBefore is the size of the log contents before compression.
+	SizeBefore int64
+	// SizeAfter is the number of compressed bytes appended to Path.
+	SizeAfter int64
+	// Err is set if the compression failed; the uncompressed contents are then left in the .tmp file.
+	Err error
+}
+
+// CompressCurrentFile compresses the contents of the current log file without rotating it.
+// The file is renamed to <name>.tmp and a new, empty <name> takes its place, so writes continue uninterrupted.
+// The .tmp file is then compressed into <name>.gz in the background and removed; compressing the same file again
+// appends another gzip member to <name>.gz, which gzip readers treat as one stream.
+// Only one compression can run at a time. Completion is reported on the channel returned by CompressEvents.
+// The file is swapped with the log directory write lock held, so no logger of the process writes meanwhile, but
+// other loggers writing to the same file keep writing to the .tmp file and lose the lines written once it is removed;
+// compress the file only from the logger that writes to it.
+func (l *FileLogger) CompressCurrentFile() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return errors.New("logger is closed")
+	}
+	if l.CurrentLogFile == nil || l.externalFile {
+		l.mu.Unlock()
+		return errors.New("no log file to compress")
+	}
+	if l.compressing {
+		l.mu.Unlock()
+		return errors.New("compression already in progress")
+	}
+
+	path := l.CurrentLogFile.Name()
+	tmpPath := path + ".tmp"
+	if l.dirLock != nil {
+		l.dirLock.mu.Lock()
+	}
+	err := l.swapLogFile(path, tmpPath)
+	if l.dirLock != nil {
+		l.dirLock.mu.Unlock()
+	}
+	if err != nil {
+		l.mu.Unlock()
+		return err
+	}
+	l.compressing = true
+	events := l.compressEvents
+	l.mu.Unlock()
+
+	l.goBackground(func(done <-chan struct{}) {
+		event := compressFile(tmpPath, path+".gz")
+
+		l.mu.Lock()
+		l.compressing = false
+		l.mu.Unlock()
+
+		if events != nil {
+			select {
+			case events <- event:
+			case <-done:
+			}
+		}
+	})
+	return nil
+}
+
+// swapLogFile renames the current log file at path to tmpPath and continues logging to a new file at path.
+// On error the file is renamed back and logging continues to it.
+func (l *FileLogger) swapLogFile(path, tmpPath string) error {
+	if err := os.Rename(path, tmpPath); err != nil {
+		return fmt.Errorf("failed renaming log file: %w", err)
+	}
+
+	logFile, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err == nil {
+		l.closeCurrentFile()
+		// The new file starts empty, so the filter of the renamed one must not drop its entries.
+		if l.DeduplicateAcrossProcesses {
+			removeDedupFilter(path)
+		}
+		err = l.setLogFile(logFile)
+	}
+	if err != nil {
+		if logFile != nil {
+			logFile.Close()
+		}
+		os.Rename(tmpPath, path)
+		return fmt.Errorf("failed opening new log file: %w", err)
+	}
+	return nil
+}
+
+// CompressEvents returns the channel on which the results of CompressCurrentFile, and of the compression of rotated
+// files if CompressRotated is set, are sent.
+// Call it before compressing; events of compressions started earlier are not delivered.
+// The channel is buffered, but once it is full a compression waits for the event to be received or for Close.
+func (l *FileLogger) CompressEvents() <-chan CompressEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.compressEvents == nil {
+		l.compressEvents = make(chan CompressEvent, compressEventsBuffer)
+	}
+	return l.compressEvents
+}
+
+// compressFile appends the gzip compressed contents of src to dst and removes src.
+func compressFile(src, dst string) CompressEvent {
+	event := CompressEvent{Path: dst}
+
+	in, err := os.Open(src)
+	if err != nil {
+		event.Err = err
+		return event
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		event.Err = err
+		return event
+	}
+	info, err := out.Stat()
+	if err != nil {
+		out.Close()
+		event.Err = err
+		return event
+	}
+	offset := info.Size()
+
+	gz := gzip.NewWriter(out)
+	event.SizeBefore, err = io.Copy(gz, in)
+	if err == nil {
+		err = gz.Close()
+	}
+	if err == nil {
+		info, err = out.Stat()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		event.Err = err
+		return event
+	}
+	event.SizeAfter = info.Size() - offset
+
+	in.Close()
+	event.Err = os.Remove(src)
+	return event
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCompressCurrentFile(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	events := logger.CompressEvents()
+	path := logger.CurrentLogFile.Name()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			logger.LogInfo(fmt.Sprintf("line %d", i))
+		}
+	}()
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		if err := logger.CompressCurrentFile(); err != nil {
+			t.Fatalf("failed to compress log file: %s", err)
+		}
+
+		select {
+		case event := <-events:
+			if event.Err != nil {
+				t.Fatalf("compression failed: %s", event.Err)
+			}
+			if event.Path != path+".gz" {
+				t.Errorf("expected path %s; got %s", path+".gz", event.Path)
+			}
+			if event.SizeAfter <= 0 || (event.SizeBefore > 100 && event.SizeAfter >= event.SizeBefore) {
+				t.Errorf("unexpected sizes: before %d, after %d", event.SizeBefore, event.SizeAfter)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected a compress event")
+		}
+	}
+	wg.Wait()
+
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("expected the .tmp file to be removed; got %v", err)
+	}
+
+	gzFile, err := os.Open(path + ".gz")
+	if err != nil {
+		t.Fatalf("failed to open compressed file: %s", err)
+	}
+	defer gzFile.Close()
+	r, err := gzip.NewReader(gzFile)
+	if err != nil {
+		t.Fatalf("failed to read compressed file: %s", err)
+	}
+	compressed, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to decompress: %s", err)
+	}
+
+	content := string(compressed) + readLogFile(t, logger)
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	if len(lines) != 200 {
+		t.Fatalf("expected 200 lines; got %d", len(lines))
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, fmt.Sprintf("INFO line %d", i)) {
+			t.Fatalf("expected line %d in order; got %q", i, line)
+		}
+	}
+}
+
+func TestCompressCurrentFileClosed(t *testing.T) {
+	logger := newTestLogger(t)
+	logger.Close()
+
+	if err := logger.CompressCurrentFile(); err == nil {
+		t.Errorf("expected an error compressing after Close")
+	}
+}
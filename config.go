@@ -0,0 +1,188 @@
+package logger
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoggerConfig holds the logger settings that can be read from a config file by NewLoggerFromFile.
+// Settings that take functions, such as WithWatchdog or WithOnAfterRotate, can only be given as options.
+type LoggerConfig struct {
+	DevMode bool `json:"dev_mode" yaml:"dev_mode"`
+	// AppDir is the subdirectory of the user's home directory where logs are stored, as for NewLogger.
+	AppDir string `json:"app_dir" yaml:"app_dir"`
+	// LogDir is the directory where logs are stored; it takes precedence over AppDir when set.
+	LogDir                string         `json:"log_dir,omitempty" yaml:"log_dir,omitempty"`
+	Prefix                string         `json:"prefix" yaml:"prefix"`
+	IncludeHostname       bool           `json:"include_hostname" yaml:"include_hostname"`
+	MinLevel              LogLevel       `json:"min_level" yaml:"min_level"`
+	ChangeMinLevel        LogLevel       `json:"change_min_level" yaml:"change_min_level"`
+	CacheStatsMinLevel    LogLevel       `json:"cache_stats_min_level" yaml:"cache_stats_min_level"`
+	NetworkLogMinLevel    LogLevel       `json:"network_log_min_level" yaml:"network_log_min_level"`
+	RateLimitMinLevel     LogLevel       `json:"rate_limit_min_level" yaml:"rate_limit_min_level"`
+	ConnectionLogMinLevel LogLevel       `json:"connection_log_min_level" yaml:"connection_log_min_level"`
+	SeqPadding            int            `json:"seq_padding" yaml:"seq_padding"`
+	Rotation              RotationPolicy `json:"rotation" yaml:"rotation"`
+	IncludePIDInFilename  bool           `json:"include_pid_in_filename" yaml:"include_pid_in_filename"`
+	MaxLinesPerFile       int64          `json:"max_lines_per_file" yaml:"max_lines_per_file"`
+	MaxFileSizeBytes      int64          `json:"max_file_size_bytes" yaml:"max_file_size_bytes"`
+	CompressRotated       bool           `json:"compress_rotated" yaml:"compress_rotated"`
+	SecurityAuditFile     string         `json:"security_audit_file,omitempty" yaml:"security_audit_file,omitempty"`
+	MaxBytesLogged        int            `json:"max_bytes_logged" yaml:"max_bytes_logged"`
+	MaxMultilineLines     int            `json:"max_multiline_lines" yaml:"max_multiline_lines"`
+	LogQueryParams        bool           `json:"log_query_params" yaml:"log_query_params"`
+	MaxQueryParamLen      int            `json:"max_query_param_len" yaml:"max_query_param_len"`
+	MaxHTTPBodyLogBytes   int            `json:"max_http_body_log_bytes" yaml:"max_http_body_log_bytes"`
+	FileOpThresholdMs     int64          `json:"file_op_threshold_ms" yaml:"file_op_threshold_ms"`
+	RingBufferSize        int            `json:"ring_buffer_size" yaml:"ring_buffer_size"`
+	// EncryptionKey is the hex encoded AES key; the log files are not encrypted when it is empty.
+	EncryptionKey              string   `json:"encryption_key,omitempty" yaml:"encryption_key,omitempty"`
+	RedactConfigKeys           []string `json:"redact_config_keys" yaml:"redact_config_keys"`
+	MemStatsKeys               []string `json:"mem_stats_keys" yaml:"mem_stats_keys"`
+	KubernetesMode             bool     `json:"kubernetes_mode" yaml:"kubernetes_mode"`
+	WriteChecksum              bool     `json:"write_checksum" yaml:"write_checksum"`
+	ChecksumAlgorithm          string   `json:"checksum_algorithm" yaml:"checksum_algorithm"`
+	TextEncoding               string   `json:"text_encoding" yaml:"text_encoding"`
+	DeduplicateAcrossProcesses bool     `json:"deduplicate_across_processes" yaml:"deduplicate_across_processes"`
+	BloomFalsePositiveRate     float64  `json:"bloom_false_positive_rate" yaml:"bloom_false_positive_rate"`
+}
+
+// DefaultLoggerConfig returns the settings used for the keys missing from a config file:
+// INFO level, 10 MB log files, UTF-8 encoding, no encryption, checksums or deduplication, 1024 bytes per LogBytes dump,
+// 100 lines per LogMultiline block and 64 characters per LogQL parameter.
+func DefaultLoggerConfig() LoggerConfig {
+	return LoggerConfig{
+		MinLevel:               LogLevelInfo,
+		ChangeMinLevel:         LogLevelInfo,
+		MaxFileSizeBytes:       defaultMaxFileSizeBytes,
+		MaxBytesLogged:         defaultMaxBytesLogged,
+		MaxMultilineLines:      defaultMaxMultilineLines,
+		MaxQueryParamLen:       defaultMaxQueryParamLen,
+		RedactConfigKeys:       []string{},
+		MemStatsKeys:           []string{},
+		ChecksumAlgorithm:      "sha256",
+		TextEncoding:           "utf-8",
+		BloomFalsePositiveRate: defaultBloomFalsePositiveRate,
+	}
+}
+
+// NewLoggerFromFile creates a FileLogger from a JSON or YAML config file, detected by the .json, .yaml or .yml
+// extension. Keys missing from the file keep their DefaultLoggerConfig values, and opts are applied on top.
+func NewLoggerFromFile(path string, opts ...Option) (*FileLogger, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading logger config: %w", err)
+	}
+
+	cfg := DefaultLoggerConfig()
+	if err := unmarshalConfig(path, data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed parsing logger config: %w", err)
+	}
+
+	return cfg.newLogger(opts...)
+}
+
+// WriteDefaultConfig writes DefaultLoggerConfig to path as JSON or YAML depending on its extension,
+// to be used as a starting point for a config file.
+func WriteDefaultConfig(path string) error {
+	data, err := marshalConfig(path, DefaultLoggerConfig())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func (cfg LoggerConfig) newLogger(opts ...Option) (*FileLogger, error) {
+	logDir := cfg.LogDir
+	if logDir == "" {
+		if cfg.AppDir == "" {
+			return nil, errors.New("logger config needs either app_dir or log_dir")
+		}
+		dir, err := userLogDir(cfg.AppDir)
+		if err != nil {
+			return nil, err
+		}
+		logDir = dir
+	}
+
+	cfgOpts, err := cfg.options()
+	if err != nil {
+		return nil, err
+	}
+	return newLogger(cfg.DevMode, logDir, append(cfgOpts, opts...)...)
+}
+
+// options returns the options matching the config settings.
+func (cfg LoggerConfig) options() ([]Option, error) {
+	opts := []Option{
+		WithPrefix(cfg.Prefix),
+		WithHostname(cfg.IncludeHostname),
+		WithMinLevel(cfg.MinLevel),
+		WithChangeMinLevel(cfg.ChangeMinLevel),
+		WithCacheStatsMinLevel(cfg.CacheStatsMinLevel),
+		WithNetworkLogMinLevel(cfg.NetworkLogMinLevel),
+		WithRateLimitMinLevel(cfg.RateLimitMinLevel),
+		WithConnectionLogMinLevel(cfg.ConnectionLogMinLevel),
+		WithSeqPadding(cfg.SeqPadding),
+		WithRotation(cfg.Rotation),
+		WithPIDInFilename(cfg.IncludePIDInFilename),
+		WithMaxFileSizeBytes(cfg.MaxFileSizeBytes),
+		WithMaxLinesPerFile(cfg.MaxLinesPerFile),
+		WithCompressRotated(cfg.CompressRotated),
+		WithSecurityAuditFile(cfg.SecurityAuditFile),
+		WithMaxBytesLogged(cfg.MaxBytesLogged),
+		WithMaxMultilineLines(cfg.MaxMultilineLines),
+		WithLogQueryParams(cfg.LogQueryParams),
+		WithMaxQueryParamLen(cfg.MaxQueryParamLen),
+		WithMaxHTTPBodyLogBytes(cfg.MaxHTTPBodyLogBytes),
+		WithFileOpThresholdMs(cfg.FileOpThresholdMs),
+		WithRedactConfigKeys(cfg.RedactConfigKeys...),
+		WithMemStatsKeys(cfg.MemStatsKeys...),
+		WithKubernetesMode(cfg.KubernetesMode),
+		WithTextEncoding(cfg.TextEncoding),
+	}
+	if cfg.RingBufferSize > 0 {
+		opts = append(opts, WithRingBuffer(cfg.RingBufferSize))
+	}
+	if cfg.EncryptionKey != "" {
+		key, err := hex.DecodeString(cfg.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption_key: %w", err)
+		}
+		opts = append(opts, WithEncryptionKey(key))
+	}
+	if cfg.WriteChecksum {
+		opts = append(opts, WithChecksum(cfg.ChecksumAlgorithm))
+	}
+	if cfg.DeduplicateAcrossProcesses {
+		opts = append(opts, WithCrossProcessDedup(cfg.BloomFalsePositiveRate))
+	}
+	return opts, nil
+}
+
+func unmarshalConfig(path string, data []byte, cfg *LoggerConfig) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return json.Unmarshal(data, cfg)
+	case ".yaml", ".yml":
+		return yaml.Unmarshal(data, cfg)
+	}
+	return fmt.Errorf("unsupported config file extension %q", filepath.Ext(path))
+}
+
+func marshalConfig(path string, cfg LoggerConfig) ([]byte, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return json.MarshalIndent(cfg, "", "  ")
+	case ".yaml", ".yml":
+		return yaml.Marshal(cfg)
+	}
+	return nil, fmt.Errorf("unsupported config file extension %q", filepath.Ext(path))
+}
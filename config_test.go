@@ -0,0 +1,149 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNewLoggerFromFile(t *testing.T) {
+	captureConsole(t)
+	key := bytes.Repeat([]byte{3}, 32)
+
+	for _, ext := range []string{".json", ".yaml", ".yml"} {
+		t.Run(ext, func(t *testing.T) {
+			dir := t.TempDir()
+			cfg := DefaultLoggerConfig()
+			cfg.LogDir = filepath.Join(dir, "logs")
+			cfg.Prefix = "tenant-a"
+			cfg.MinLevel = LogLevelWarn
+			cfg.ChangeMinLevel = LogLevelDebug
+			cfg.SeqPadding = 3
+			cfg.MaxLinesPerFile = 1000
+			cfg.MaxBytesLogged = 64
+			cfg.RingBufferSize = 512
+			cfg.EncryptionKey = hex.EncodeToString(key)
+			cfg.RedactConfigKeys = []string{"password"}
+			cfg.KubernetesMode = true
+			cfg.WriteChecksum = true
+			cfg.ChecksumAlgorithm = "sha1"
+
+			data, err := marshalConfig(ext, cfg)
+			if err != nil {
+				t.Fatalf("failed to marshal config: %s", err)
+			}
+			path := filepath.Join(dir, "logger"+ext)
+			if err := os.WriteFile(path, data, 0644); err != nil {
+				t.Fatalf("failed to write config: %s", err)
+			}
+
+			logger, err := NewLoggerFromFile(path)
+			if err != nil {
+				t.Fatalf("failed to create logger: %s", err)
+			}
+			defer logger.Close()
+
+			if logger.LogDir != cfg.LogDir {
+				t.Errorf("expected log dir %s; got %s", cfg.LogDir, logger.LogDir)
+			}
+			if logger.Prefix() != "tenant-a" {
+				t.Errorf("expected prefix tenant-a; got %q", logger.Prefix())
+			}
+			if logger.MinLevel() != LogLevelWarn || logger.ChangeMinLevel != LogLevelDebug {
+				t.Errorf("expected levels WARNING and DEBUG; got %s and %s", logger.MinLevel(), logger.ChangeMinLevel)
+			}
+			if logger.SeqPadding != 3 || logger.MaxLinesPerFile != 1000 || logger.MaxBytesLogged != 64 {
+				t.Errorf("expected seq padding 3, 1000 lines and 64 bytes; got %d, %d and %d", logger.SeqPadding, logger.MaxLinesPerFile, logger.MaxBytesLogged)
+			}
+			if logger.BufferSize() != 512 {
+				t.Errorf("expected a 512 byte ring buffer; got %d", logger.BufferSize())
+			}
+			if !bytes.Equal(logger.EncryptionKey, key) {
+				t.Errorf("expected encryption key %x; got %x", key, logger.EncryptionKey)
+			}
+			if !reflect.DeepEqual(logger.RedactConfigKeys, []string{"password"}) {
+				t.Errorf("expected redacted keys [password]; got %v", logger.RedactConfigKeys)
+			}
+			if !logger.KubernetesMode || !logger.WriteChecksum || logger.ChecksumAlgorithm != "sha1" {
+				t.Errorf("expected kubernetes mode and sha1 checksums; got %t, %t and %q", logger.KubernetesMode, logger.WriteChecksum, logger.ChecksumAlgorithm)
+			}
+		})
+	}
+}
+
+func TestNewLoggerFromFileDefaults(t *testing.T) {
+	captureConsole(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logger.yaml")
+	if err := os.WriteFile(path, []byte("log_dir: "+filepath.Join(dir, "logs")+"\nmin_level: error\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %s", err)
+	}
+
+	logger, err := NewLoggerFromFile(path)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer logger.Close()
+
+	if logger.MinLevel() != LogLevelError {
+		t.Errorf("expected min level ERROR; got %s", logger.MinLevel())
+	}
+	if logger.ChangeMinLevel != LogLevelInfo || logger.MaxBytesLogged != defaultMaxBytesLogged {
+		t.Errorf("expected default change level and max bytes; got %s and %d", logger.ChangeMinLevel, logger.MaxBytesLogged)
+	}
+}
+
+func TestNewLoggerFromFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+	}{
+		{name: "unsupported extension", filename: "logger.toml", content: "log_dir = 'logs'"},
+		{name: "invalid json", filename: "logger.json", content: "{"},
+		{name: "invalid level", filename: "logger.yaml", content: "log_dir: logs\nmin_level: loud\n"},
+		{name: "invalid rotation", filename: "logger.yaml", content: "log_dir: logs\nrotation: hourly\n"},
+		{name: "missing directory", filename: "logger.json", content: "{}"},
+		{name: "invalid encryption key", filename: "logger.json", content: `{"log_dir": "logs", "encryption_key": "zz"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.filename)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write config: %s", err)
+			}
+
+			if logger, err := NewLoggerFromFile(path); err == nil {
+				logger.Close()
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestWriteDefaultConfig(t *testing.T) {
+	for _, ext := range []string{".json", ".yaml"} {
+		t.Run(ext, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "logger"+ext)
+			if err := WriteDefaultConfig(path); err != nil {
+				t.Fatalf("failed to write default config: %s", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read config: %s", err)
+			}
+			var cfg LoggerConfig
+			if err := unmarshalConfig(path, data, &cfg); err != nil {
+				t.Fatalf("failed to parse config: %s", err)
+			}
+			if !reflect.DeepEqual(cfg, DefaultLoggerConfig()) {
+				t.Errorf("expected %+v; got %+v", DefaultLoggerConfig(), cfg)
+			}
+		})
+	}
+}
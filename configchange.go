@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// WithConfigChangeHook sets a hook called after every configuration change is logged by LogConfigChange,
+// e.g. to notify a security team.
+func WithConfigChangeHook(fn func(key string, oldVal, newVal interface{})) Option {
+	return func(l *FileLogger) {
+		l.ConfigChangeHook = fn
+	}
+}
+
+// LogConfigChange logs a runtime configuration change as `config_change` with the key, old, new, source and actor
+// fields, followed by the extra fields. The source tells where the change came from, such as "file", "api" or "env",
+// and the actor who made it. Configuration changes are audit records: they are written at INFO level regardless of
+// the minimum level and are never suppressed. ConfigChangeHook is called synchronously once written.
+func (l *FileLogger) LogConfigChange(key string, oldVal, newVal interface{}, source, actor string, fields map[string]interface{}) {
+	l.writeAt(LogLevelInfo, formatConfigChange(key, oldVal, newVal, source, actor, fields))
+
+	if l.ConfigChangeHook != nil {
+		l.ConfigChangeHook(key, oldVal, newVal)
+	}
+}
+
+func formatConfigChange(key string, oldVal, newVal interface{}, source, actor string, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+5)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["key"] = key
+	fields["old"] = strconv.Quote(fmt.Sprint(oldVal))
+	fields["new"] = strconv.Quote(fmt.Sprint(newVal))
+	fields["source"] = source
+	fields["actor"] = actor
+	return fmt.Sprintf("config_change %s", formatFields(fields))
+}
@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogConfigChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		oldVal   interface{}
+		newVal   interface{}
+		source   string
+		actor    string
+		fields   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "file",
+			key:      "max_connections",
+			oldVal:   100,
+			newVal:   200,
+			source:   "file",
+			actor:    "system",
+			expected: `INFO config_change actor=system key=max_connections new="200" old="100" source=file`,
+		},
+		{
+			name:     "api with fields",
+			key:      "feature.beta",
+			oldVal:   false,
+			newVal:   true,
+			source:   "api",
+			actor:    "alice",
+			fields:   map[string]interface{}{"request_id": "abc"},
+			expected: `INFO config_change actor=alice key=feature.beta new="true" old="false" request_id=abc source=api`,
+		},
+		{
+			name:     "env with spaces",
+			key:      "greeting",
+			oldVal:   "hello world",
+			newVal:   nil,
+			source:   "env",
+			actor:    "system",
+			expected: `INFO config_change actor=system key=greeting new="<nil>" old="hello world" source=env`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogConfigChange(tt.key, tt.oldVal, tt.newVal, tt.source, tt.actor, tt.fields)
+
+			if content := readLogFile(t, logger); !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestLogConfigChangeAlwaysLogged(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelError))
+	logger.AddSuppressRule(FieldMatchRule("key", "timeout"))
+
+	logger.LogInfo("regular message")
+	logger.LogConfigChange("timeout", "5s", "10s", "api", "bob", nil)
+
+	content := readLogFile(t, logger)
+	if strings.Contains(content, "regular message") {
+		t.Errorf("expected INFO messages below the minimum level to be dropped; got %q", content)
+	}
+	if !strings.Contains(content, "INFO config_change") {
+		t.Errorf("expected the config change regardless of the minimum level; got %q", content)
+	}
+}
+
+func TestConfigChangeHook(t *testing.T) {
+	captureConsole(t)
+	var calls []string
+	logger := newTestLogger(t, WithConfigChangeHook(func(key string, oldVal, newVal interface{}) {
+		calls = append(calls, key)
+		if oldVal != 1 || newVal != 2 {
+			t.Errorf("expected 1 -> 2; got %v -> %v", oldVal, newVal)
+		}
+	}))
+
+	logger.LogConfigChange("retries", 1, 2, "api", "system", nil)
+
+	if len(calls) != 1 || calls[0] != "retries" {
+		t.Errorf("expected the hook to be called once for retries; got %v", calls)
+	}
+	if !strings.Contains(readLogFile(t, logger), "config_change") {
+		t.Errorf("expected the change to be logged before the hook")
+	}
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Event types logged by LogConnectionEvent.
+const (
+	ConnectionConnect    = "connect"
+	ConnectionDisconnect = "disconnect"
+	ConnectionError      = "error"
+	ConnectionTimeout    = "timeout"
+	ConnectionRetry      = "retry"
+)
+
+// connectionStartTimeKey is the field holding the time.Time at which the connection attempt started.
+const connectionStartTimeKey = "start_time"
+
+// WithConnectionLogMinLevel sets the level at which LogConnectionEvent writes connect and disconnect events;
+// the default is LogLevelDebug.
+func WithConnectionLogMinLevel(level LogLevel) Option {
+	return func(l *FileLogger) {
+		l.ConnectionLogMinLevel = level
+	}
+}
+
+// LogConnectionEvent logs a connection lifecycle event as `connection` with the event, protocol, local and remote
+// fields, followed by the extra fields. If the fields hold a time.Time under start_time, it is replaced with
+// duration_ms, the time elapsed since. Error events and events with a non-nil err are logged at ERROR level with
+// the error field, timeouts and retries at WARNING level, and other events at ConnectionLogMinLevel.
+func (l *FileLogger) LogConnectionEvent(eventType, protocol, localAddr, remoteAddr string, err error, fields map[string]interface{}) {
+	level := l.ConnectionLogMinLevel
+	switch {
+	case err != nil, eventType == ConnectionError:
+		level = LogLevelError
+	case eventType == ConnectionTimeout, eventType == ConnectionRetry:
+		level = LogLevelWarn
+	}
+	if !l.enabled(level) {
+		return
+	}
+	l.logAt(level, formatConnectionEvent(eventType, protocol, localAddr, remoteAddr, err, fields, time.Now()))
+}
+
+func formatConnectionEvent(eventType, protocol, localAddr, remoteAddr string, err error, extra map[string]interface{}, now time.Time) string {
+	fields := make(map[string]interface{}, len(extra)+5)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	if start, ok := fields[connectionStartTimeKey].(time.Time); ok {
+		delete(fields, connectionStartTimeKey)
+		fields["duration_ms"] = now.Sub(start).Milliseconds()
+	}
+	fields["event"] = eventType
+	fields["protocol"] = protocol
+	fields["local"] = localAddr
+	fields["remote"] = remoteAddr
+	if err != nil {
+		fields["error"] = strconv.Quote(err.Error())
+	}
+	return fmt.Sprintf("connection %s", formatFields(fields))
+}
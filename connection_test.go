@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogConnectionEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		err       error
+		fields    map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "connect",
+			eventType: ConnectionConnect,
+			expected:  "DEBUG connection event=connect local=10.0.0.2:50000 protocol=tcp remote=10.0.0.9:5432",
+		},
+		{
+			name:      "disconnect",
+			eventType: ConnectionDisconnect,
+			fields:    map[string]interface{}{"pool": "primary"},
+			expected:  "DEBUG connection event=disconnect local=10.0.0.2:50000 pool=primary protocol=tcp remote=10.0.0.9:5432",
+		},
+		{
+			name:      "error",
+			eventType: ConnectionError,
+			err:       errors.New("tls: handshake failure"),
+			expected:  `ERROR connection error="tls: handshake failure" event=error local=10.0.0.2:50000 protocol=tcp remote=10.0.0.9:5432`,
+		},
+		{
+			name:      "timeout",
+			eventType: ConnectionTimeout,
+			expected:  "WARNING connection event=timeout local=10.0.0.2:50000 protocol=tcp remote=10.0.0.9:5432",
+		},
+		{
+			name:      "retry",
+			eventType: ConnectionRetry,
+			fields:    map[string]interface{}{"attempt": 2},
+			expected:  "WARNING connection attempt=2 event=retry local=10.0.0.2:50000 protocol=tcp remote=10.0.0.9:5432",
+		},
+		{
+			name:      "connect with error",
+			eventType: ConnectionConnect,
+			err:       errors.New("refused"),
+			expected:  `ERROR connection error="refused" event=connect local=10.0.0.2:50000 protocol=tcp remote=10.0.0.9:5432`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogConnectionEvent(tt.eventType, "tcp", "10.0.0.2:50000", "10.0.0.9:5432", tt.err, tt.fields)
+
+			if content := readLogFile(t, logger); !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestConnectionEventDuration(t *testing.T) {
+	now := time.Now()
+	fields := map[string]interface{}{"start_time": now.Add(-1500 * time.Millisecond)}
+
+	message := formatConnectionEvent(ConnectionConnect, "grpc", "a", "b", nil, fields, now)
+
+	expected := "connection duration_ms=1500 event=connect local=a protocol=grpc remote=b"
+	if message != expected {
+		t.Errorf("expected %q; got %q", expected, message)
+	}
+	if _, ok := fields["start_time"]; !ok {
+		t.Errorf("expected the caller's fields to be left unchanged")
+	}
+}
+
+func TestConnectionLogMinLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithConnectionLogMinLevel(LogLevelInfo))
+
+	logger.LogConnectionEvent(ConnectionConnect, "tcp", "a", "b", nil, nil)
+	if content := readLogFile(t, logger); !strings.Contains(content, "INFO connection event=connect") {
+		t.Errorf("expected the event at INFO level; got %q", content)
+	}
+}
@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+)
+
+// AddContextExtractor registers fn to add fields from the context to every entry logged with the *Ctx methods.
+// The results of all extractors are merged in registration order, so later extractors win on conflicting keys.
+// Extractors may be called concurrently and must be safe for concurrent use.
+func (l *FileLogger) AddContextExtractor(fn func(context.Context) map[string]interface{}) {
+	l.extractorsMu.Lock()
+	defer l.extractorsMu.Unlock()
+
+	l.extractors = append(l.extractors, fn)
+}
+
+// ClearContextExtractors removes all the extractors registered with AddContextExtractor.
+func (l *FileLogger) ClearContextExtractors() {
+	l.extractorsMu.Lock()
+	defer l.extractorsMu.Unlock()
+
+	l.extractors = nil
+}
+
+// CorrelationIDKey is the context key of the correlation ID added to the entries of the *Ctx methods.
+//
+//	ctx = context.WithValue(ctx, logger.CorrelationIDKey{}, r.Header.Get("X-Correlation-ID"))
+type CorrelationIDKey struct{}
+
+// CorrelationIDFromContext returns the correlation ID stored in ctx under key, or "" if there is none.
+// The value may be a string or a fmt.Stringer.
+func CorrelationIDFromContext(ctx context.Context, key interface{}) string {
+	switch id := ctx.Value(key).(type) {
+	case string:
+		return id
+	case fmt.Stringer:
+		return id.String()
+	}
+	return ""
+}
+
+// LogErrorCtx logs err at the level chosen like LogError with the correlation ID stored under CorrelationIDKey,
+// the fields extracted from ctx and the given fields appended as key=value pairs.
+// Extracted fields win over the correlation ID and the given fields win over both.
+func (l *FileLogger) LogErrorCtx(ctx context.Context, err error, fields map[string]interface{}) {
+	l.logAt(l.errorLevel(err), l.withContextFields(ctx, err.Error(), fields))
+}
+
+// LogWarnCtx logs message at WARN level with context fields like LogErrorCtx.
+func (l *FileLogger) LogWarnCtx(ctx context.Context, message string, fields map[string]interface{}) {
+	l.logAt(LogLevelWarn, l.withContextFields(ctx, message, fields))
+}
+
+// LogInfoCtx logs message at INFO level with context fields like LogErrorCtx.
+func (l *FileLogger) LogInfoCtx(ctx context.Context, message string, fields map[string]interface{}) {
+	l.logAt(LogLevelInfo, l.withContextFields(ctx, message, fields))
+}
+
+// LogDebugCtx logs message at DEBUG level with context fields like LogErrorCtx.
+func (l *FileLogger) LogDebugCtx(ctx context.Context, message string, fields map[string]interface{}) {
+	l.logAt(LogLevelDebug, l.withContextFields(ctx, message, fields))
+}
+
+// withContextFields appends the correlation ID and the fields extracted from ctx, merged with fields, to message.
+func (l *FileLogger) withContextFields(ctx context.Context, message string, fields map[string]interface{}) string {
+	l.extractorsMu.RLock()
+	extractors := l.extractors
+	l.extractorsMu.RUnlock()
+
+	merged := make(map[string]interface{}, len(fields)+1)
+	if id := CorrelationIDFromContext(ctx, CorrelationIDKey{}); id != "" {
+		merged["correlation_id"] = id
+	}
+	for _, extract := range extractors {
+		for k, v := range extract(ctx) {
+			merged[k] = v
+		}
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	if len(merged) == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s %s", message, formatFields(merged))
+}
@@ -0,0 +1,152 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type contextKey string
+
+func TestContextExtractors(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.AddContextExtractor(func(ctx context.Context) map[string]interface{} {
+		return map[string]interface{}{"tenant": ctx.Value(contextKey("tenant"))}
+	})
+	logger.AddContextExtractor(func(ctx context.Context) map[string]interface{} {
+		return map[string]interface{}{"region": "eu", "source": "extractor"}
+	})
+	logger.AddContextExtractor(func(ctx context.Context) map[string]interface{} {
+		return map[string]interface{}{"region": "us"}
+	})
+
+	ctx := context.WithValue(context.Background(), contextKey("tenant"), "acme")
+
+	tests := []struct {
+		name     string
+		log      func()
+		expected string
+	}{
+		{
+			name:     "extracted fields",
+			log:      func() { logger.LogInfoCtx(ctx, "order created", nil) },
+			expected: "INFO order created region=us source=extractor tenant=acme\n",
+		},
+		{
+			name:     "call fields win",
+			log:      func() { logger.LogWarnCtx(ctx, "slow query", map[string]interface{}{"source": "call", "ms": 900}) },
+			expected: "WARNING slow query ms=900 region=us source=call tenant=acme\n",
+		},
+		{
+			name:     "error",
+			log:      func() { logger.LogErrorCtx(ctx, errors.New("payment failed"), nil) },
+			expected: "ERROR payment failed region=us source=extractor tenant=acme\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.log()
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected line ending with %q; got %q", tt.expected, content)
+			}
+		})
+	}
+
+	logger.ClearContextExtractors()
+	logger.LogInfoCtx(ctx, "cleared", nil)
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, "INFO cleared\n") {
+		t.Errorf("expected no extracted fields after clearing; got %q", content)
+	}
+}
+
+func TestContextExtractorsConcurrent(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			logger.AddContextExtractor(func(context.Context) map[string]interface{} {
+				return map[string]interface{}{"worker": true}
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			logger.LogInfoCtx(context.Background(), "concurrent", nil)
+		}()
+	}
+	wg.Wait()
+}
+
+type requestID string
+
+func (id requestID) String() string { return string(id) }
+
+func TestCorrelationIDFromContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{name: "missing", ctx: context.Background(), expected: ""},
+		{name: "string", ctx: context.WithValue(context.Background(), CorrelationIDKey{}, "abc-123"), expected: "abc-123"},
+		{name: "stringer", ctx: context.WithValue(context.Background(), CorrelationIDKey{}, requestID("def-456")), expected: "def-456"},
+		{name: "other type", ctx: context.WithValue(context.Background(), CorrelationIDKey{}, 42), expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CorrelationIDFromContext(tt.ctx, CorrelationIDKey{}); got != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCorrelationIDInCtxMethods(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	ctx := context.WithValue(context.Background(), CorrelationIDKey{}, "abc-123")
+
+	logger.LogErrorCtx(ctx, errors.New("failed"), nil)
+	logger.LogWarnCtx(ctx, "slow", nil)
+	logger.LogInfoCtx(ctx, "handled", map[string]interface{}{"status": 200})
+	logger.LogDebugCtx(ctx, "details", nil)
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 lines; got %q", lines)
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, "correlation_id=abc-123") {
+			t.Errorf("expected the correlation ID in %q", line)
+		}
+	}
+}
+
+func TestWithCorrelationID(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	child := logger.WithCorrelationID("abc-123")
+	child.LogInfo("first")
+	child.WithField("step", 2).LogWarn("second")
+	child.LogError(errors.New("third"))
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines; got %q", lines)
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, "correlation_id=abc-123") {
+			t.Errorf("expected the correlation ID in %q", line)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+)
+
+// timestampLength is the length of the date and time written in front of every log file line.
+const timestampLength = len(logTimeLayout + " ")
+
+// LogCursor marks the end of the log file at the time it was created, so that the entries written after it can be
+// read back, e.g. to assert in a test that a call logged something.
+type LogCursor struct {
+	logger *FileLogger
+	path   string
+	info   os.FileInfo
+	offset int64
+}
+
+// Cursor returns a LogCursor at the current end of the log file. Encrypted or UTF-16 log files and non-file writers
+// set by SetPrimaryOutput cannot be read back.
+func (l *FileLogger) Cursor() (*LogCursor, error) {
+	if len(l.EncryptionKey) > 0 {
+		return nil, errors.New("encrypted log files cannot be read back")
+	}
+	if !isUTF8(l.TextEncoding) {
+		return nil, errors.New("UTF-16 log files cannot be read back")
+	}
+
+	path := l.currentLogPath()
+	if path == "" {
+		return nil, errors.New("logger does not write to a file")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &LogCursor{logger: l, path: path, info: info, offset: info.Size()}, nil
+}
+
+// Entries returns the entries written since the cursor was created, following the logger to the files it rotated to.
+// The continuation lines of multiline entries are joined like in WatchFile. If the file was replaced at the same path,
+// e.g. by CompressCurrentFile, the new file is read from its start.
+func (c *LogCursor) Entries() ([]LogEntry, error) {
+	offset := c.offset
+	if info, err := os.Stat(c.path); err == nil && !os.SameFile(c.info, info) {
+		offset = 0
+	}
+	lines, err := readLinesFrom(c.path, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	path := c.path
+	current := c.logger.currentLogPath()
+	for current != "" && path != current {
+		next := nextLogFilePath(path, c.logger.SeqPadding)
+		if next == "" {
+			next = current
+		}
+		rotated, err := readLinesFrom(next, 0)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, rotated...)
+		path = next
+	}
+
+	var entries []LogEntry
+	prefix := c.logger.linePrefixLocked()
+	for _, line := range lines {
+		entry, ok := parseLogLine(line, prefix)
+		if ok {
+			entries = append(entries, entry)
+		} else if len(entries) > 0 {
+			last := &entries[len(entries)-1]
+			last.Message += "\n" + line
+			last.Raw += "\n" + line
+		}
+	}
+	return entries, nil
+}
+
+func readLinesFrom(path string, offset int64) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
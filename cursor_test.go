@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCursorEntries(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMaxLinesPerFile(2), WithHostname(true))
+	logger.LogInfo("before the cursor")
+
+	cursor, err := logger.Cursor()
+	if err != nil {
+		t.Fatalf("failed to create cursor: %s", err)
+	}
+	logger.LogWarn("retrying request attempt=2")
+	logger.LogError(errors.New("request failed\nstatus=503"))
+	logger.LogInfo("request served")
+
+	entries, err := cursor.Entries()
+	if err != nil {
+		t.Fatalf("failed to read entries: %s", err)
+	}
+	expected := []struct {
+		level   LogLevel
+		message string
+	}{
+		{level: LogLevelWarn, message: "retrying request attempt=2"},
+		{level: LogLevelError, message: "request failed\nstatus=503"},
+		{level: LogLevelInfo, message: "request served"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries; got %+v", len(expected), entries)
+	}
+	for i, e := range expected {
+		if entries[i].Level != e.level || entries[i].Message != e.message {
+			t.Errorf("expected %s %q; got %s %q", e.level, e.message, entries[i].Level, entries[i].Message)
+		}
+	}
+}
+
+func TestCursorAfterCompress(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.LogInfo("before the cursor")
+
+	cursor, err := logger.Cursor()
+	if err != nil {
+		t.Fatalf("failed to create cursor: %s", err)
+	}
+	if err := logger.CompressCurrentFile(); err != nil {
+		t.Fatalf("failed to compress log file: %s", err)
+	}
+	logger.LogInfo("after compressing")
+
+	entries, err := cursor.Entries()
+	if err != nil {
+		t.Fatalf("failed to read entries: %s", err)
+	}
+	if len(entries) != 1 || entries[0].Message != "after compressing" {
+		t.Errorf("expected the entry written to the new file; got %+v", entries)
+	}
+}
+
+func TestCursorEncrypted(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithEncryptionKey(make([]byte, 32)))
+
+	if _, err := logger.Cursor(); err == nil {
+		t.Errorf("expected an error for an encrypted log file")
+	}
+}
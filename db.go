@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	dbBatchSize     = 100
+	dbFlushInterval = 5 * time.Second
+	// dbQueueSize is the number of entries waiting to be inserted beyond which new entries are dropped.
+	dbQueueSize = 10 * dbBatchSize
+)
+
+var tableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+type dbEntry struct {
+	ts      time.Time
+	level   LogLevel
+	message string
+}
+
+type dbOutput struct {
+	entries chan dbEntry
+	done    <-chan struct{}
+	dropped *atomic.Uint64
+}
+
+// MigrateLogTable creates the table used by AddDBOutput if it does not exist yet, with the columns
+// id SERIAL, ts TIMESTAMPTZ, level TEXT, message TEXT and fields JSONB.
+func MigrateLogTable(db *sql.DB, tableName string) error {
+	if !tableNamePattern.MatchString(tableName) {
+		return fmt.Errorf("invalid table name %q", tableName)
+	}
+
+	_, err := db.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id SERIAL PRIMARY KEY,
+	ts TIMESTAMPTZ NOT NULL,
+	level TEXT NOT NULL,
+	message TEXT NOT NULL,
+	fields JSONB
+)`, tableName))
+	return err
+}
+
+// AddDBOutput inserts every entry written to the log file into tableName, which must have the columns created by
+// MigrateLogTable. Rows are inserted in the background in batches of 100, at least every 5 seconds, and Close
+// inserts the pending ones. Logging never waits for the database: while 1000 entries are pending, new ones are
+// dropped and counted in LoggerStats.DroppedDBEntries. The fields column is left NULL, since fields are part of the message.
+// The insert uses $1-style placeholders, which PostgreSQL and SQLite accept.
+func (l *FileLogger) AddDBOutput(db *sql.DB, tableName string) error {
+	if !tableNamePattern.MatchString(tableName) {
+		return fmt.Errorf("invalid table name %q", tableName)
+	}
+
+	rows, err := db.Query(fmt.Sprintf("SELECT id, ts, level, message, fields FROM %s WHERE 1 = 0", tableName))
+	if err != nil {
+		return fmt.Errorf("failed validating log table %s: %w", tableName, err)
+	}
+	rows.Close()
+
+	output := &dbOutput{entries: make(chan dbEntry, dbQueueSize), dropped: &l.dbDropped}
+	insert := fmt.Sprintf("INSERT INTO %s (ts, level, message) VALUES ($1, $2, $3)", tableName)
+
+	ready := make(chan struct{})
+	l.goBackground(func(done <-chan struct{}) {
+		output.done = done
+		close(ready)
+		output.run(db, insert)
+	})
+	<-ready
+
+	l.outputsMu.Lock()
+	defer l.outputsMu.Unlock()
+	l.dbOutputs = append(l.dbOutputs, output)
+	return nil
+}
+
+// write queues the entry without blocking, since it is called with outputsMu held; the entry is dropped and counted
+// if the queue is full.
+func (o *dbOutput) write(level LogLevel, message string) {
+	select {
+	case o.entries <- dbEntry{ts: time.Now().UTC(), level: level, message: message}:
+	case <-o.done:
+	default:
+		o.dropped.Add(1)
+	}
+}
+
+// run inserts the entries in batches until the logger is closed, inserting the pending ones before returning.
+func (o *dbOutput) run(db *sql.DB, insert string) {
+	ticker := time.NewTicker(dbFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]dbEntry, 0, dbBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := insertBatch(db, insert, batch); err != nil {
+			console().Printf("%s failed writing %d log entries to the database: %s", LogLevelError, len(batch), err.Error())
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case entry := <-o.entries:
+			batch = append(batch, entry)
+			if len(batch) >= dbBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-o.done:
+			for {
+				select {
+				case entry := <-o.entries:
+					batch = append(batch, entry)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func insertBatch(db *sql.DB, insert string, batch []dbEntry) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(insert)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	for _, entry := range batch {
+		if _, err := stmt.Exec(entry.ts, entry.level.String(), entry.message); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// logToDB queues the entry for every database output; line is the level followed by the message.
+// The caller must hold outputsMu.
+func (l *FileLogger) logToDB(level LogLevel, line string) {
+	message := strings.TrimPrefix(line, level.String()+" ")
+	for _, o := range l.dbOutputs {
+		o.write(level, message)
+	}
+}
@@ -0,0 +1,56 @@
+//go:build sqlite
+
+package logger
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestAddDBOutputSQLite(t *testing.T) {
+	captureConsole(t)
+	db, err := sql.Open("sqlite3", "file::memory:?cache=shared")
+	if err != nil {
+		t.Fatalf("failed to open database: %s", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if err := MigrateLogTable(db, "app_logs"); err != nil {
+		t.Fatalf("failed to migrate log table: %s", err)
+	}
+	logger := newTestLogger(t)
+	if err := logger.AddDBOutput(db, "app_logs"); err != nil {
+		t.Fatalf("failed to add database output: %s", err)
+	}
+
+	logger.LogInfo("stored in sqlite")
+	logger.LogWarn("also stored")
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	rows, err := db.Query("SELECT level, message FROM app_logs ORDER BY ts")
+	if err != nil {
+		t.Fatalf("failed to query log table: %s", err)
+	}
+	defer rows.Close()
+
+	expected := [][2]string{{"INFO", "stored in sqlite"}, {"WARNING", "also stored"}}
+	i := 0
+	for rows.Next() {
+		var level, message string
+		if err := rows.Scan(&level, &message); err != nil {
+			t.Fatalf("failed to scan row: %s", err)
+		}
+		if i >= len(expected) || level != expected[i][0] || message != expected[i][1] {
+			t.Errorf("unexpected row %d: %s %s", i, level, message)
+		}
+		i++
+	}
+	if i != len(expected) {
+		t.Errorf("expected %d rows; got %d", len(expected), i)
+	}
+}
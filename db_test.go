@@ -0,0 +1,172 @@
+package logger
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// recordingDriver is a database/sql driver that accepts the log table queries and records the inserted rows.
+type recordingDriver struct {
+	mu     sync.Mutex
+	tables map[string]bool
+	rows   [][]driver.Value
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) {
+	return &recordingConn{driver: d}, nil
+}
+
+func (d *recordingDriver) insertedRows() [][]driver.Value {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([][]driver.Value(nil), d.rows...)
+}
+
+type recordingConn struct {
+	driver *recordingDriver
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{conn: c, query: query}, nil
+}
+
+func (c *recordingConn) Close() error { return nil }
+
+func (c *recordingConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *recordingConn) Commit() error { return nil }
+
+func (c *recordingConn) Rollback() error { return nil }
+
+type recordingStmt struct {
+	conn  *recordingConn
+	query string
+}
+
+func (s *recordingStmt) Close() error { return nil }
+
+func (s *recordingStmt) NumInput() int { return -1 }
+
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fields := strings.Fields(s.query)
+	switch {
+	case strings.HasPrefix(s.query, "CREATE TABLE IF NOT EXISTS"):
+		d.tables[fields[5]] = true
+	case strings.HasPrefix(s.query, "INSERT INTO"):
+		d.rows = append(d.rows, args)
+	default:
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *recordingStmt) Query([]driver.Value) (driver.Rows, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fields := strings.Fields(s.query)
+	if !d.tables[fields[len(fields)-5]] {
+		return nil, errors.New("no such table")
+	}
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string { return []string{"id", "ts", "level", "message", "fields"} }
+
+func (emptyRows) Close() error { return nil }
+
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+var registerDriverOnce sync.Once
+var testDriver = &recordingDriver{tables: make(map[string]bool)}
+
+func openRecordingDB(t *testing.T) (*sql.DB, *recordingDriver) {
+	registerDriverOnce.Do(func() { sql.Register("flogg-recording", testDriver) })
+
+	testDriver.mu.Lock()
+	testDriver.tables = make(map[string]bool)
+	testDriver.rows = nil
+	testDriver.mu.Unlock()
+
+	db, err := sql.Open("flogg-recording", "")
+	if err != nil {
+		t.Fatalf("failed to open database: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, testDriver
+}
+
+func TestAddDBOutput(t *testing.T) {
+	captureConsole(t)
+	db, recorder := openRecordingDB(t)
+	logger := newTestLogger(t)
+
+	if err := logger.AddDBOutput(db, "app_logs"); err == nil {
+		t.Fatalf("expected an error before the table exists")
+	}
+	if err := MigrateLogTable(db, "app_logs"); err != nil {
+		t.Fatalf("failed to migrate log table: %s", err)
+	}
+	if err := logger.AddDBOutput(db, "app_logs"); err != nil {
+		t.Fatalf("failed to add database output: %s", err)
+	}
+
+	for i := 0; i < dbBatchSize+5; i++ {
+		logger.LogInfo(fmt.Sprintf("entry %d", i))
+	}
+	logger.LogWarn("last entry")
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	rows := recorder.insertedRows()
+	if len(rows) != dbBatchSize+6 {
+		t.Fatalf("expected %d rows after close; got %d", dbBatchSize+6, len(rows))
+	}
+	if rows[0][1] != "INFO" || rows[0][2] != "entry 0" {
+		t.Errorf("expected the first row to be INFO entry 0; got %v", rows[0][1:])
+	}
+	if last := rows[len(rows)-1]; last[1] != "WARNING" || last[2] != "last entry" {
+		t.Errorf("expected the last row to be WARNING last entry; got %v", last[1:])
+	}
+}
+
+func TestDBOutputDropsWhenFull(t *testing.T) {
+	var dropped atomic.Uint64
+	output := &dbOutput{entries: make(chan dbEntry, 1), dropped: &dropped}
+
+	output.write(LogLevelInfo, "queued")
+	output.write(LogLevelInfo, "dropped")
+
+	if len(output.entries) != 1 || dropped.Load() != 1 {
+		t.Errorf("expected 1 queued and 1 dropped entry; got %d and %d", len(output.entries), dropped.Load())
+	}
+}
+
+func TestInvalidTableName(t *testing.T) {
+	db, _ := openRecordingDB(t)
+	logger := newTestLogger(t)
+
+	for _, name := range []string{"logs; DROP TABLE users", "", "1logs", "a.b.c"} {
+		if err := MigrateLogTable(db, name); err == nil {
+			t.Errorf("expected an error migrating table %q", name)
+		}
+		if err := logger.AddDBOutput(db, name); err == nil {
+			t.Errorf("expected an error adding table %q", name)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package logger
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"sync/atomic"
+)
+
+// defaultBloomCapacity is the number of distinct entries the bloom filter is sized for when rotation sets no limit.
+const defaultBloomCapacity = 100000
+
+// averageLineBytes is the assumed size of a log line, used to estimate how many entries fit in a file before size
+// based rotation; with the default limit of 10 MB it gives defaultBloomCapacity.
+const averageLineBytes = 100
+
+const defaultBloomFalsePositiveRate = 0.01
+
+// WithCrossProcessDedup skips entries that are already in the log file, i.e. with the same timestamp and message,
+// so that several processes can share a log directory without duplicating lines. Written entries are recorded
+// in a bloom filter kept next to the log file in <logfile>.bloom, which is removed once the file is rotated away or
+// compressed by CompressCurrentFile. The filter can report false positives at falsePositiveRate, which drops unique
+// entries; a rate outside (0, 1) defaults to 0.01.
+func WithCrossProcessDedup(falsePositiveRate float64) Option {
+	return func(l *FileLogger) {
+		l.DeduplicateAcrossProcesses = true
+		l.BloomFalsePositiveRate = falsePositiveRate
+	}
+}
+
+// bloomCapacity returns the number of entries a log file holds before rotation, which the bloom filter is sized for.
+func (l *FileLogger) bloomCapacity() int64 {
+	capacity := int64(defaultBloomCapacity)
+	if l.MaxFileSizeBytes > 0 {
+		capacity = max(1, l.MaxFileSizeBytes/averageLineBytes)
+	}
+	if l.MaxLinesPerFile > 0 {
+		capacity = min(capacity, l.MaxLinesPerFile)
+	}
+	return capacity
+}
+
+type dedupWriter struct {
+	w          io.Writer
+	filter     *os.File
+	bits       uint64
+	hashes     int
+	duplicates *atomic.Uint64
+}
+
+// newDedupWriter opens the bloom filter of the log file at logPath, sized for capacity entries.
+func newDedupWriter(w io.Writer, logPath string, capacity int64, falsePositiveRate float64, duplicates *atomic.Uint64) (*dedupWriter, error) {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = defaultBloomFalsePositiveRate
+	}
+	n := float64(max(1, capacity))
+	bits := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	hashes := int(math.Max(1, math.Round(bits/n*math.Ln2)))
+
+	filter, err := os.OpenFile(logPath+".bloom", os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dedupWriter{
+		w:          w,
+		filter:     filter,
+		bits:       uint64(bits),
+		hashes:     hashes,
+		duplicates: duplicates,
+	}, nil
+}
+
+// Write skips p if the bloom filter already holds it, and records it in the filter and writes it otherwise.
+// The filter file is locked meanwhile, so that processes sharing it never both write the same entry.
+func (d *dedupWriter) Write(p []byte) (int, error) {
+	if err := lockFile(d.filter); err != nil {
+		return 0, err
+	}
+	defer unlockFile(d.filter)
+
+	sum := sha256.Sum256(p)
+	h1 := binary.BigEndian.Uint64(sum[:8])
+	h2 := binary.BigEndian.Uint64(sum[8:16]) | 1
+
+	seen := true
+	for i := 0; i < d.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % d.bits
+		offset := int64(bit / 8)
+		mask := byte(1) << (bit % 8)
+
+		var b [1]byte
+		if _, err := d.filter.ReadAt(b[:], offset); err != nil && err != io.EOF {
+			return 0, err
+		}
+		if b[0]&mask != 0 {
+			continue
+		}
+
+		seen = false
+		b[0] |= mask
+		if _, err := d.filter.WriteAt(b[:], offset); err != nil {
+			return 0, err
+		}
+	}
+
+	if seen {
+		d.duplicates.Add(1)
+		return len(p), nil
+	}
+	return d.w.Write(p)
+}
+
+// Close closes the bloom filter file.
+func (d *dedupWriter) Close() error {
+	return d.filter.Close()
+}
+
+// removeDedupFilter removes the bloom filter of the log file at logPath, if any.
+func removeDedupFilter(logPath string) error {
+	if err := os.Remove(logPath + ".bloom"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// closeDedupFilter closes the bloom filter of fileLog, if it deduplicates entries.
+func closeDedupFilter(fileLog *log.Logger) error {
+	if fileLog == nil {
+		return nil
+	}
+	if d, ok := fileLog.Writer().(*dedupWriter); ok {
+		return d.Close()
+	}
+	return nil
+}
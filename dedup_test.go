@@ -0,0 +1,140 @@
+package logger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDedupWriter(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "shared.log")
+	var output bytes.Buffer
+	var firstDuplicates, secondDuplicates atomic.Uint64
+	first, err := newDedupWriter(&output, logPath, defaultBloomCapacity, 0.01, &firstDuplicates)
+	if err != nil {
+		t.Fatalf("failed to create writer: %s", err)
+	}
+	defer first.Close()
+	second, err := newDedupWriter(&output, logPath, defaultBloomCapacity, 0.01, &secondDuplicates)
+	if err != nil {
+		t.Fatalf("failed to create writer: %s", err)
+	}
+	defer second.Close()
+
+	lines := []struct {
+		writer *dedupWriter
+		line   string
+	}{
+		{writer: first, line: "2025/01/02 15:04:05 INFO job started\n"},
+		{writer: second, line: "2025/01/02 15:04:05 INFO job started\n"},
+		{writer: second, line: "2025/01/02 15:04:06 INFO job started\n"},
+		{writer: first, line: "2025/01/02 15:04:06 INFO job finished\n"},
+		{writer: first, line: "2025/01/02 15:04:06 INFO job finished\n"},
+	}
+	for _, l := range lines {
+		if n, err := l.writer.Write([]byte(l.line)); err != nil || n != len(l.line) {
+			t.Fatalf("expected %d bytes written; got %d, %v", len(l.line), n, err)
+		}
+	}
+
+	expected := "2025/01/02 15:04:05 INFO job started\n2025/01/02 15:04:06 INFO job started\n2025/01/02 15:04:06 INFO job finished\n"
+	if output.String() != expected {
+		t.Errorf("expected %q; got %q", expected, output.String())
+	}
+	if firstDuplicates.Load() != 1 || secondDuplicates.Load() != 1 {
+		t.Errorf("expected 1 duplicate per writer; got %d and %d", firstDuplicates.Load(), secondDuplicates.Load())
+	}
+	if _, err := os.Stat(logPath + ".bloom"); err != nil {
+		t.Errorf("expected the bloom filter sidecar file to exist: %s", err)
+	}
+}
+
+func TestBloomCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxBytes int64
+		maxLines int64
+		expected int64
+	}{
+		{name: "default size", maxBytes: defaultMaxFileSizeBytes, expected: defaultBloomCapacity},
+		{name: "small size", maxBytes: 5000, expected: 50},
+		{name: "line limit", maxBytes: defaultMaxFileSizeBytes, maxLines: 200, expected: 200},
+		{name: "no limits", expected: defaultBloomCapacity},
+		{name: "tiny size", maxBytes: 10, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &FileLogger{MaxFileSizeBytes: tt.maxBytes, MaxLinesPerFile: tt.maxLines}
+			if got := l.bloomCapacity(); got != tt.expected {
+				t.Errorf("expected capacity %d; got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCrossProcessDedup(t *testing.T) {
+	captureConsole(t)
+	logDir := t.TempDir()
+
+	first, err := newLogger(false, logDir, WithCrossProcessDedup(0.01))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer first.Close()
+	second, err := newLogger(false, logDir, WithCrossProcessDedup(0.01))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer second.Close()
+
+	first.LogInfo("shared line")
+	second.LogInfo("shared line")
+
+	// Both entries are kept only if the clock moved to the next second in between, giving them different timestamps.
+	content := readLogFile(t, first)
+	written := strings.Count(content, "INFO shared line")
+	duplicates := first.Stats().DuplicateCount + second.Stats().DuplicateCount
+	if written+int(duplicates) != 2 {
+		t.Fatalf("expected every entry to be written or skipped; got %d written and %d skipped", written, duplicates)
+	}
+	if written == 2 && content[:timestampLength] == strings.Split(content, "\n")[1][:timestampLength] {
+		t.Errorf("expected entries with the same timestamp to be deduplicated; got %q", content)
+	}
+}
+
+func TestDedupFilterRemoved(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithCrossProcessDedup(0.01), WithMaxLinesPerFile(1))
+	events := logger.CompressEvents()
+
+	logger.LogInfo("first")
+	path := logger.CurrentLogFile.Name()
+	logger.LogInfo("second")
+	if logger.CurrentLogFile.Name() == path {
+		t.Fatalf("expected the log file to be rotated")
+	}
+	if _, err := os.Stat(path + ".bloom"); !os.IsNotExist(err) {
+		t.Errorf("expected the bloom filter of the rotated file to be removed; got %v", err)
+	}
+
+	path = logger.CurrentLogFile.Name()
+	if err := logger.CompressCurrentFile(); err != nil {
+		t.Fatalf("failed to compress log file: %s", err)
+	}
+	if event := <-events; event.Err != nil {
+		t.Fatalf("compression failed: %s", event.Err)
+	}
+	logger.LogInfo("second")
+
+	// The entry may repeat the timestamp of the compressed one, but the new file has a filter of its own.
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, "INFO second\n") {
+		t.Errorf("expected the entry in the new file; got %q", content)
+	}
+	if logger.CurrentLogFile.Name() != path {
+		t.Errorf("expected the compressed file to be kept; got %s", logger.CurrentLogFile.Name())
+	}
+}
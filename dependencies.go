@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+)
+
+// DependencyStatus is the result of checking a service dependency, such as a database or a downstream API, on startup.
+type DependencyStatus struct {
+	Name    string
+	Address string
+	Status  string
+	Latency time.Duration
+	Err     error
+}
+
+// LogStartupDependencies logs one dependency_check line per dependency, at ERROR level for those with an error and
+// at INFO level otherwise, followed by a summary line `all_dependencies_ok=<bool> count=<N> failed=<M>`.
+// The summary is logged at ERROR level if any dependency failed.
+func (l *FileLogger) LogStartupDependencies(deps []DependencyStatus) {
+	failed := 0
+	for _, dep := range deps {
+		if dep.Err != nil {
+			failed++
+			l.logAt(LogLevelError, formatDependency(dep))
+		} else {
+			l.logAt(LogLevelInfo, formatDependency(dep))
+		}
+	}
+
+	level := LogLevelInfo
+	if failed > 0 {
+		level = LogLevelError
+	}
+	l.logAt(level, fmt.Sprintf("all_dependencies_ok=%t count=%d failed=%d", failed == 0, len(deps), failed))
+}
+
+func formatDependency(dep DependencyStatus) string {
+	message := fmt.Sprintf("dependency_check %s status=%s latency_ms=%d address=%s",
+		dep.Name, dep.Status, dep.Latency.Milliseconds(), dep.Address)
+	if dep.Err != nil {
+		message = fmt.Sprintf("%s error=%s", message, dep.Err.Error())
+	}
+	return message
+}
@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogStartupDependencies(t *testing.T) {
+	captureConsole(t)
+
+	postgres := DependencyStatus{Name: "postgres", Address: "db:5432", Status: "up", Latency: 12 * time.Millisecond}
+	redis := DependencyStatus{Name: "redis", Address: "cache:6379", Status: "up", Latency: 3 * time.Millisecond}
+	billing := DependencyStatus{Name: "billing", Address: "https://billing", Status: "down", Latency: 5 * time.Second, Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name     string
+		deps     []DependencyStatus
+		expected []string
+	}{
+		{
+			name: "all passing",
+			deps: []DependencyStatus{postgres, redis},
+			expected: []string{
+				"INFO dependency_check postgres status=up latency_ms=12 address=db:5432",
+				"INFO dependency_check redis status=up latency_ms=3 address=cache:6379",
+				"INFO all_dependencies_ok=true count=2 failed=0",
+			},
+		},
+		{
+			name: "mixed",
+			deps: []DependencyStatus{postgres, billing, redis},
+			expected: []string{
+				"INFO dependency_check postgres status=up latency_ms=12 address=db:5432",
+				"ERROR dependency_check billing status=down latency_ms=5000 address=https://billing error=connection refused",
+				"INFO dependency_check redis status=up latency_ms=3 address=cache:6379",
+				"ERROR all_dependencies_ok=false count=3 failed=1",
+			},
+		},
+		{
+			name:     "no dependencies",
+			expected: []string{"INFO all_dependencies_ok=true count=0 failed=0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t)
+			logger.LogStartupDependencies(tt.deps)
+
+			lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+			if len(lines) != len(tt.expected) {
+				t.Fatalf("expected %d lines; got %q", len(tt.expected), lines)
+			}
+			for i, line := range lines {
+				if !strings.HasSuffix(line, tt.expected[i]) {
+					t.Errorf("expected line %d to end with %q; got %q", i, tt.expected[i], line)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package logger
+
+import "fmt"
+
+// WarnDeprecated logs a deprecation notice at WARNING level the first time it is called for feature, through the
+// OnceWith deduplication, in the form `DEPRECATED <feature>: use <replacement> (removed in <removalVersion>)`
+// followed by the extra fields. The replacement and removal version are left out if empty.
+func (l *FileLogger) WarnDeprecated(feature, replacement, removalVersion string, fields map[string]interface{}) {
+	l.OnceWith("deprecated\x00" + feature).LogWarn(formatDeprecation(feature, replacement, removalVersion, fields))
+}
+
+func formatDeprecation(feature, replacement, removalVersion string, fields map[string]interface{}) string {
+	message := fmt.Sprintf("DEPRECATED %s", feature)
+	if replacement != "" {
+		message = fmt.Sprintf("%s: use %s", message, replacement)
+	}
+	if removalVersion != "" {
+		message = fmt.Sprintf("%s (removed in %s)", message, removalVersion)
+	}
+	if len(fields) > 0 {
+		message = fmt.Sprintf("%s %s", message, formatFields(fields))
+	}
+	return message
+}
@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWarnDeprecated(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	for i := 0; i < 3; i++ {
+		logger.WarnDeprecated("Config.Path", "Config.Dir", "v2.0.0", nil)
+		logger.WarnDeprecated("LogRaw", "LogBytes", "", map[string]interface{}{"caller": "billing"})
+		logger.WarnDeprecated("OldFlag", "", "", nil)
+	}
+	logger.OnceWith("OldFlag").LogWarn("unrelated once key")
+
+	content := readLogFile(t, logger)
+	tests := []struct {
+		entry    string
+		expected int
+	}{
+		{entry: "WARNING DEPRECATED Config.Path: use Config.Dir (removed in v2.0.0)\n", expected: 1},
+		{entry: "WARNING DEPRECATED LogRaw: use LogBytes caller=billing\n", expected: 1},
+		{entry: "WARNING DEPRECATED OldFlag\n", expected: 1},
+		{entry: "WARNING unrelated once key\n", expected: 1},
+	}
+	for _, tt := range tests {
+		if got := strings.Count(content, tt.entry); got != tt.expected {
+			t.Errorf("expected %q %d times; got %d", tt.entry, tt.expected, got)
+		}
+	}
+}
+
+func TestWarnDeprecatedAfterLevelChange(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelError))
+
+	logger.WarnDeprecated("Config.Path", "Config.Dir", "v2.0.0", nil)
+	logger.SetMinLevel(LogLevelDebug)
+	logger.WarnDeprecated("Config.Path", "Config.Dir", "v2.0.0", nil)
+	logger.WarnDeprecated("Config.Path", "Config.Dir", "v2.0.0", nil)
+
+	content := readLogFile(t, logger)
+	if got := strings.Count(content, "WARNING DEPRECATED Config.Path"); got != 1 {
+		t.Errorf("expected the notice once after the level was lowered; got it %d times in %q", got, content)
+	}
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// LogDiff logs the keys that differ between before and after, as `DIFF <label>: added=<keys> removed=<keys> changed=<keys>`.
+// Both values are compared through their JSON encoding, and nested objects are reported with dotted keys, e.g. `db.host`.
+// A nil value is treated as an empty object.
+func (l *FileLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	l.logAt(level, formatDiff(label, before, after))
+}
+
+func formatDiff(label string, before, after interface{}) string {
+	added, removed, changed, err := diffValues(before, after)
+	if err != nil {
+		return fmt.Sprintf("DIFF %s: diff_error=%q", label, err.Error())
+	}
+	return fmt.Sprintf("DIFF %s: added=%s removed=%s changed=%s", label,
+		strings.Join(added, ","), strings.Join(removed, ","), strings.Join(changed, ","))
+}
+
+// diffValues returns the sorted keys that were added, removed and changed going from before to after.
+func diffValues(before, after interface{}) (added, removed, changed []string, err error) {
+	beforeObj, err := jsonObject(before)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	afterObj, err := jsonObject(after)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	diffObjects("", beforeObj, afterObj, &added, &removed, &changed)
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return added, removed, changed, nil
+}
+
+func diffObjects(prefix string, before, after map[string]interface{}, added, removed, changed *[]string) {
+	for key, b := range before {
+		a, ok := after[key]
+		if !ok {
+			*removed = append(*removed, prefix+key)
+			continue
+		}
+
+		bObj, bIsObj := b.(map[string]interface{})
+		aObj, aIsObj := a.(map[string]interface{})
+		if bIsObj && aIsObj {
+			diffObjects(prefix+key+".", bObj, aObj, added, removed, changed)
+		} else if !reflect.DeepEqual(a, b) {
+			*changed = append(*changed, prefix+key)
+		}
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			*added = append(*added, prefix+key)
+		}
+	}
+}
+
+func jsonObject(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("value is not a JSON object: %s", data)
+	}
+	if obj == nil {
+		obj = map[string]interface{}{}
+	}
+	return obj, nil
+}
@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+type diffDBConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type diffConfigV1 struct {
+	Region  string       `json:"region"`
+	Workers int          `json:"workers"`
+	Legacy  bool         `json:"legacy"`
+	DB      diffDBConfig `json:"db"`
+}
+
+type diffConfigV2 struct {
+	Region  string       `json:"region"`
+	Workers int          `json:"workers"`
+	Timeout string       `json:"timeout"`
+	DB      diffDBConfig `json:"db"`
+}
+
+func TestFormatDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   interface{}
+		after    interface{}
+		expected string
+	}{
+		{
+			name:     "added, removed and changed fields",
+			before:   diffConfigV1{Region: "eu", Workers: 4, Legacy: true, DB: diffDBConfig{Host: "db1", Port: 5432}},
+			after:    diffConfigV2{Region: "eu", Workers: 8, Timeout: "5s", DB: diffDBConfig{Host: "db2", Port: 5432}},
+			expected: "DIFF config: added=timeout removed=legacy changed=db.host,workers",
+		},
+		{
+			name:     "no changes",
+			before:   diffConfigV1{Region: "eu"},
+			after:    diffConfigV1{Region: "eu"},
+			expected: "DIFF config: added= removed= changed=",
+		},
+		{
+			name:     "nil before",
+			before:   nil,
+			after:    map[string]int{"b": 2, "a": 1},
+			expected: "DIFF config: added=a,b removed= changed=",
+		},
+		{
+			name:     "object replaced by scalar",
+			before:   map[string]interface{}{"db": map[string]string{"host": "db1"}},
+			after:    map[string]interface{}{"db": "db1:5432"},
+			expected: "DIFF config: added= removed= changed=db",
+		},
+		{
+			name:     "not an object",
+			before:   []int{1},
+			after:    nil,
+			expected: `DIFF config: diff_error="value is not a JSON object: [1]"`,
+		},
+		{
+			name:     "marshal error",
+			before:   map[string]interface{}{"ch": make(chan int)},
+			after:    nil,
+			expected: `DIFF config: diff_error="json: unsupported type: chan int"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatDiff("config", tt.before, tt.after)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogDiff(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogDiff(LogLevelWarn, "limits", map[string]int{"rps": 10}, map[string]int{"rps": 20})
+
+	expected := "WARNING DIFF limits: added= removed= changed=rps\n"
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, expected) {
+		t.Errorf("expected line ending with %q; got %q", expected, content)
+	}
+}
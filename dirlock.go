@@ -0,0 +1,57 @@
+package logger
+
+import (
+	"path/filepath"
+	"sync"
+)
+
+// dirLock serializes the writes of all the loggers of the process that share a log directory.
+type dirLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+var (
+	dirLocksMu sync.Mutex
+	dirLocks   = make(map[string]*dirLock) // keyed by absolute log directory
+)
+
+// registerLogDir returns the lock of dir, creating it for the first logger of the directory.
+func registerLogDir(dir string) *dirLock {
+	dirLocksMu.Lock()
+	defer dirLocksMu.Unlock()
+
+	key := absLogDir(dir)
+	lock, ok := dirLocks[key]
+	if !ok {
+		lock = &dirLock{}
+		dirLocks[key] = lock
+	}
+	lock.refs++
+	return lock
+}
+
+// UnregisterLogDir releases a logger's reference to the write lock shared by the loggers of dir,
+// removing the lock once the last logger of the directory is gone. Close calls it, so it is only needed
+// for loggers that are dropped without being closed.
+func UnregisterLogDir(dir string) {
+	dirLocksMu.Lock()
+	defer dirLocksMu.Unlock()
+
+	key := absLogDir(dir)
+	lock, ok := dirLocks[key]
+	if !ok {
+		return
+	}
+	lock.refs--
+	if lock.refs <= 0 {
+		delete(dirLocks, key)
+	}
+}
+
+func absLogDir(dir string) string {
+	if abs, err := filepath.Abs(dir); err == nil {
+		return abs
+	}
+	return filepath.Clean(dir)
+}
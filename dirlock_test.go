@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSharedLogDir(t *testing.T) {
+	captureConsole(t)
+	logDir := t.TempDir()
+
+	loggers := make([]*FileLogger, 2)
+	for i := range loggers {
+		l, err := newLogger(false, logDir)
+		if err != nil {
+			t.Fatalf("failed to create logger: %s", err)
+		}
+		loggers[i] = l
+	}
+	if loggers[0].dirLock != loggers[1].dirLock {
+		t.Fatalf("expected loggers of the same directory to share the lock")
+	}
+
+	const perLogger = 500
+	payload := strings.Repeat("x", 512)
+	var wg sync.WaitGroup
+	for i, l := range loggers {
+		wg.Add(1)
+		go func(i int, l *FileLogger) {
+			defer wg.Done()
+			for j := 0; j < perLogger; j++ {
+				l.LogInfo(fmt.Sprintf("logger=%d seq=%d %s", i, j, payload))
+			}
+		}(i, l)
+	}
+	wg.Wait()
+	for _, l := range loggers {
+		l.Close()
+	}
+
+	entries := readLogDir(t, logDir)
+	if len(entries) != 1 {
+		t.Fatalf("expected both loggers to write to one file; got %d files", len(entries))
+	}
+	content, err := os.ReadFile(filepath.Join(logDir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	if len(lines) != 2*perLogger {
+		t.Fatalf("expected %d lines; got %d", 2*perLogger, len(lines))
+	}
+	next := make(map[string]int)
+	for _, line := range lines {
+		var i, j int
+		if _, err := fmt.Sscanf(line[strings.Index(line, "INFO "):], "INFO logger=%d seq=%d", &i, &j); err != nil || !strings.HasSuffix(line, " "+payload) {
+			t.Fatalf("expected an intact line; got %q", line)
+		}
+		key := fmt.Sprint(i)
+		if j != next[key] {
+			t.Fatalf("expected seq %d of logger %d; got %d", next[key], i, j)
+		}
+		next[key]++
+	}
+}
+
+func TestUnregisterLogDir(t *testing.T) {
+	logDir := t.TempDir()
+	first, err := newLogger(false, logDir)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	second, err := newLogger(false, logDir)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+
+	registered := func() bool {
+		dirLocksMu.Lock()
+		defer dirLocksMu.Unlock()
+		_, ok := dirLocks[absLogDir(logDir)]
+		return ok
+	}
+
+	first.Close()
+	if !registered() {
+		t.Errorf("expected the directory to stay registered while a logger uses it")
+	}
+	second.Close()
+	if registered() {
+		t.Errorf("expected the directory to be unregistered once its last logger is closed")
+	}
+}
@@ -0,0 +1,32 @@
+package logger
+
+import "context"
+
+// Drain waits until everything written to the current log file has been synced to disk, e.g. in a shutdown hook
+// before the process exits. It returns ctx.Err() if ctx is done first, in which case the sync keeps going in the
+// background. Writes are not buffered by the logger, so Drain only waits for the sync and for writes in progress.
+// Entries queued for database outputs are not included; Close inserts them.
+func (l *FileLogger) Drain(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	synced := make(chan error, 1)
+	go func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if l.closed || l.CurrentLogFile == nil {
+			synced <- nil
+			return
+		}
+		synced <- l.CurrentLogFile.Sync()
+	}()
+
+	select {
+	case err := <-synced:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
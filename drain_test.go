@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDrain(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.LogInfo("before shutdown")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := logger.Drain(ctx); err != nil {
+		t.Errorf("expected no error; got %s", err)
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	logger := newTestLogger(t)
+
+	// Holding the logger's mutex keeps the sync from starting, as a slow disk would.
+	logger.mu.Lock()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := logger.Drain(ctx)
+	logger.mu.Unlock()
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %s; got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestDrainCancelled(t *testing.T) {
+	logger := newTestLogger(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := logger.Drain(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s; got %v", context.Canceled, err)
+	}
+}
+
+func TestDrainClosed(t *testing.T) {
+	logger := newTestLogger(t)
+	logger.Close()
+
+	if err := logger.Drain(context.Background()); err != nil {
+		t.Errorf("expected no error after Close; got %s", err)
+	}
+}
@@ -0,0 +1,30 @@
+package logger
+
+import "fmt"
+
+// WithEmergencyFn sets a hook called after every emergency message is written, e.g. to page the on-call engineer.
+func WithEmergencyFn(fn func(message string, fields map[string]interface{})) Option {
+	return func(l *FileLogger) {
+		l.EmergencyFn = fn
+	}
+}
+
+// LogEmergency logs message at EMERGENCY level, above FATAL, regardless of the minimum level.
+// Unlike fatal messages it does not terminate the program. EmergencyFn is called synchronously once written.
+func (l *FileLogger) LogEmergency(message string) {
+	l.LogEmergencyWith(message, nil)
+}
+
+// LogEmergencyWith logs an emergency message like LogEmergency and appends fields, e.g. built with NewFields,
+// as key=value pairs. EmergencyFn receives the fields.
+func (l *FileLogger) LogEmergencyWith(message string, fields map[string]interface{}) {
+	line := message
+	if len(fields) > 0 {
+		line = fmt.Sprintf("%s %s", line, formatFields(fields))
+	}
+	l.logAt(LogLevelEmergency, line)
+
+	if l.EmergencyFn != nil {
+		l.EmergencyFn(message, fields)
+	}
+}
@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLogEmergency(t *testing.T) {
+	consoleOutput := captureConsole(t)
+
+	var hookMessage string
+	var hookFields map[string]interface{}
+	var writtenBeforeHook bool
+	var logger *FileLogger
+	logger = newTestLogger(t, WithMinLevel(LogLevelFatal), WithEmergencyFn(func(message string, fields map[string]interface{}) {
+		hookMessage, hookFields = message, fields
+		writtenBeforeHook = strings.Contains(readLogFile(t, logger), "EMERGENCY database unreachable")
+	}))
+
+	logger.LogError(errors.New("filtered out"))
+	logger.LogEmergencyWith("database unreachable", map[string]interface{}{"region": "eu"})
+
+	expected := "EMERGENCY database unreachable region=eu\n"
+	content := readLogFile(t, logger)
+	if !strings.HasSuffix(content, expected) {
+		t.Errorf("expected line ending with %q; got %q", expected, content)
+	}
+	if strings.Contains(content, "filtered out") {
+		t.Errorf("expected messages below the minimum level to be dropped; got %q", content)
+	}
+	if !strings.Contains(consoleOutput.String(), expected) {
+		t.Errorf("expected the emergency on the console; got %q", consoleOutput.String())
+	}
+	if hookMessage != "database unreachable" || hookFields["region"] != "eu" {
+		t.Errorf("expected the hook to receive the message and fields; got %q %v", hookMessage, hookFields)
+	}
+	if !writtenBeforeHook {
+		t.Errorf("expected the hook to run after the message was written")
+	}
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// WithTextEncoding sets the encoding of the log files: "utf-8", the default, "utf-16le" or "utf-16be", e.g. for
+// Windows log aggregators that require UTF-16. UTF-16 files start with a byte order mark and cannot be encrypted.
+func WithTextEncoding(enc string) Option {
+	return func(l *FileLogger) {
+		l.TextEncoding = enc
+	}
+}
+
+// NewEncoding returns the encoding named by enc, as accepted by WithTextEncoding, e.g. to read UTF-16 log files with
+// NewEncoding(enc).NewDecoder().Reader(f). The UTF-16 decoders consume the byte order mark.
+func NewEncoding(enc string) (encoding.Encoding, error) {
+	switch strings.ToLower(enc) {
+	case "", "utf-8", "utf8":
+		return unicode.UTF8, nil
+	case "utf-16le":
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), nil
+	case "utf-16be":
+		return unicode.UTF16(unicode.BigEndian, unicode.UseBOM), nil
+	}
+	return nil, fmt.Errorf("unsupported text encoding %q", enc)
+}
+
+// isUTF8 reports whether enc names the default UTF-8 encoding, which needs no transformation.
+func isUTF8(enc string) bool {
+	e, err := NewEncoding(enc)
+	return err == nil && e == unicode.UTF8
+}
+
+// newEncodingWriter returns a writer that encodes what is written to w with enc.
+// A byte order mark is written first if w is an empty file, so that reopening a file does not repeat it.
+func newEncodingWriter(w io.Writer, enc string) (io.Writer, error) {
+	var bom []byte
+	var encoder *encoding.Encoder
+	switch strings.ToLower(enc) {
+	case "utf-16le":
+		bom = []byte{0xFF, 0xFE}
+		encoder = unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder()
+	case "utf-16be":
+		bom = []byte{0xFE, 0xFF}
+		encoder = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewEncoder()
+	default:
+		return nil, fmt.Errorf("unsupported text encoding %q", enc)
+	}
+
+	if file, ok := w.(*os.File); ok {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() == 0 {
+			if _, err := file.Write(bom); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return transform.NewWriter(w, encoder), nil
+}
+
+// newDecodingReader returns a reader that decodes r, a log file written with enc, into UTF-8.
+func newDecodingReader(r io.Reader, enc string) (io.Reader, error) {
+	e, err := NewEncoding(enc)
+	if err != nil {
+		return nil, err
+	}
+	return e.NewDecoder().Reader(r), nil
+}
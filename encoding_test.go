@@ -0,0 +1,101 @@
+package logger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTextEncodingRoundTrip(t *testing.T) {
+	captureConsole(t)
+	message := "héllo wörld 日本語 🚀"
+
+	tests := []struct {
+		encoding string
+		bom      []byte
+	}{
+		{encoding: "utf-8"},
+		{encoding: "utf-16le", bom: []byte{0xFF, 0xFE}},
+		{encoding: "utf-16be", bom: []byte{0xFE, 0xFF}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.encoding, func(t *testing.T) {
+			logDir := t.TempDir()
+			for i := 0; i < 2; i++ {
+				logger, err := newLogger(false, logDir, WithTextEncoding(tt.encoding))
+				if err != nil {
+					t.Fatalf("failed to create logger: %s", err)
+				}
+				logger.LogInfo(message)
+				logger.Close()
+			}
+
+			files := readLogDir(t, logDir)
+			if len(files) != 1 {
+				t.Fatalf("expected 1 log file; got %d", len(files))
+			}
+			content, err := os.ReadFile(filepath.Join(logDir, files[0].Name()))
+			if err != nil {
+				t.Fatalf("failed to read log file: %s", err)
+			}
+			if tt.bom != nil && !bytes.HasPrefix(content, tt.bom) {
+				t.Errorf("expected the byte order mark %x; got %x", tt.bom, content[:2])
+			}
+			if tt.bom != nil && bytes.Count(content, tt.bom) != 1 {
+				t.Errorf("expected a single byte order mark after reopening the file")
+			}
+
+			enc, err := NewEncoding(tt.encoding)
+			if err != nil {
+				t.Fatalf("failed to get encoding: %s", err)
+			}
+			decoded, err := enc.NewDecoder().Bytes(content)
+			if err != nil {
+				t.Fatalf("failed to decode log file: %s", err)
+			}
+			if got := strings.Count(string(decoded), "INFO "+message+"\n"); got != 2 {
+				t.Errorf("expected the message twice; got %q", decoded)
+			}
+		})
+	}
+}
+
+func TestNewEncoding(t *testing.T) {
+	tests := []struct {
+		encoding string
+		wantErr  bool
+	}{
+		{encoding: ""},
+		{encoding: "UTF-8"},
+		{encoding: "utf-16le"},
+		{encoding: "UTF-16BE"},
+		{encoding: "latin1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.encoding, func(t *testing.T) {
+			if _, err := NewEncoding(tt.encoding); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t; got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTextEncodingVerify(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithTextEncoding("utf-16le"))
+
+	if err := logger.Verify(); err != nil {
+		t.Errorf("expected the probe to be read back; got %s", err)
+	}
+}
+
+func TestTextEncodingWithEncryption(t *testing.T) {
+	_, err := newLogger(false, t.TempDir(), WithTextEncoding("utf-16le"), WithEncryptionKey(bytes.Repeat([]byte{1}, 32)))
+	if err == nil {
+		t.Errorf("expected an error combining UTF-16 with encryption")
+	}
+}
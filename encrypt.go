@@ -0,0 +1,116 @@
+package logger
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Encrypted streams are a sequence of records, one per Write call:
+// a 4-byte big-endian length followed by the random nonce and the sealed data.
+const recordHeaderSize = 4
+
+var errInvalidRecord = errors.New("invalid encrypted record")
+
+type encryptingWriter struct {
+	w    io.Writer
+	aead cipher.AEAD
+}
+
+type decryptingReader struct {
+	r    io.Reader
+	aead cipher.AEAD
+	buf  []byte
+}
+
+// NewEncryptingWriter returns a writer that encrypts each Write call into its own AES-GCM record
+// with a random nonce before passing it on to w.
+//
+// Parameters:
+//   - w: the destination of the encrypted records.
+//   - key: the AES key, which must be 16, 24 or 32 bytes long.
+func NewEncryptingWriter(w io.Writer, key []byte) (io.Writer, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{w: w, aead: aead}, nil
+}
+
+// NewDecryptingReader returns a reader that decrypts a stream written by NewEncryptingWriter.
+//
+// Parameters:
+//   - r: the source of the encrypted records, usually an encrypted log file.
+//   - key: the AES key the stream was encrypted with.
+func NewDecryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{r: r, aead: aead}, nil
+}
+
+func (e *encryptingWriter) Write(p []byte) (int, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return 0, err
+	}
+
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(nonce)+len(p)+e.aead.Overhead())
+	record = append(record, nonce...)
+	record = e.aead.Seal(record, nonce, p, nil)
+	binary.BigEndian.PutUint32(record[:recordHeaderSize], uint32(len(record)-recordHeaderSize))
+
+	if _, err := e.w.Write(record); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		var header [recordHeaderSize]byte
+		if _, err := io.ReadFull(d.r, header[:]); err != nil {
+			return 0, err
+		}
+
+		size := int(binary.BigEndian.Uint32(header[:]))
+		nonceSize := d.aead.NonceSize()
+		if size < nonceSize+d.aead.Overhead() {
+			return 0, errInvalidRecord
+		}
+
+		record := make([]byte, size)
+		if _, err := io.ReadFull(d.r, record); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+
+		plaintext, err := d.aead.Open(nil, record[:nonceSize], record[nonceSize:], nil)
+		if err != nil {
+			return 0, err
+		}
+		d.buf = plaintext
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,135 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEncryptingWriter(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	lines := []string{
+		"INFO server started",
+		"WARNING disk usage at 80%",
+		"ERROR connection refused",
+	}
+
+	var encrypted bytes.Buffer
+	w, err := NewEncryptingWriter(&encrypted, key)
+	if err != nil {
+		t.Fatalf("failed to create encrypting writer: %s", err)
+	}
+	for _, line := range lines {
+		if _, err := w.Write([]byte(line + "\n")); err != nil {
+			t.Fatalf("failed to write line: %s", err)
+		}
+	}
+
+	for _, line := range lines {
+		if bytes.Contains(encrypted.Bytes(), []byte(line)) {
+			t.Errorf("expected %q to be encrypted; found it in plaintext", line)
+		}
+	}
+
+	r, err := NewDecryptingReader(bytes.NewReader(encrypted.Bytes()), key)
+	if err != nil {
+		t.Fatalf("failed to create decrypting reader: %s", err)
+	}
+	var decrypted []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		decrypted = append(decrypted, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to decrypt: %s", err)
+	}
+
+	if strings.Join(decrypted, "|") != strings.Join(lines, "|") {
+		t.Errorf("expected decrypted lines %v; got %v", lines, decrypted)
+	}
+}
+
+func TestEncryptionKeySize(t *testing.T) {
+	tests := []struct {
+		name    string
+		keySize int
+		wantErr bool
+	}{
+		{name: "AES-128", keySize: 16},
+		{name: "AES-192", keySize: 24},
+		{name: "AES-256", keySize: 32},
+		{name: "invalid size", keySize: 20, wantErr: true},
+		{name: "empty key", keySize: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEncryptingWriter(&bytes.Buffer{}, make([]byte, tt.keySize))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t; got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDecryptingReaderWrongKey(t *testing.T) {
+	var encrypted bytes.Buffer
+	w, err := NewEncryptingWriter(&encrypted, bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatalf("failed to create encrypting writer: %s", err)
+	}
+	if _, err := w.Write([]byte("INFO secret\n")); err != nil {
+		t.Fatalf("failed to write line: %s", err)
+	}
+
+	r, err := NewDecryptingReader(&encrypted, bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatalf("failed to create decrypting reader: %s", err)
+	}
+	if _, err := r.Read(make([]byte, 64)); err == nil {
+		t.Errorf("expected an error decrypting with the wrong key")
+	}
+}
+
+func TestEncryptedLogFile(t *testing.T) {
+	key := bytes.Repeat([]byte{9}, 32)
+	logFile, err := os.Create(filepath.Join(t.TempDir(), "encrypted.log"))
+	if err != nil {
+		t.Fatalf("failed to create file: %s", err)
+	}
+	defer logFile.Close()
+
+	logger := &FileLogger{LogDir: filepath.Dir(logFile.Name()), EncryptionKey: key}
+	if err := logger.setLogFile(logFile); err != nil {
+		t.Fatalf("failed to set log file: %s", err)
+	}
+	logger.FileLog.Println("INFO first line")
+	logger.FileLog.Println("INFO second line")
+
+	content, err := os.ReadFile(logFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	if bytes.Contains(content, []byte("first line")) {
+		t.Errorf("expected the log file to be encrypted")
+	}
+
+	r, err := NewDecryptingReader(bytes.NewReader(content), key)
+	if err != nil {
+		t.Fatalf("failed to create decrypting reader: %s", err)
+	}
+	decrypted := new(strings.Builder)
+	if _, err := bufio.NewReader(r).WriteTo(decrypted); err != nil {
+		t.Fatalf("failed to decrypt log file: %s", err)
+	}
+
+	if !strings.Contains(decrypted.String(), "INFO first line") || !strings.Contains(decrypted.String(), "INFO second line") {
+		t.Errorf("expected decrypted log to contain both lines; got %q", decrypted.String())
+	}
+	if strings.Count(decrypted.String(), "\n") != 2 {
+		t.Errorf("expected 2 lines; got %q", decrypted.String())
+	}
+}
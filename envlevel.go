@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// EnvLevelVar is the environment variable polled by WatchEnvLevel.
+const EnvLevelVar = "FLOGG_LOG_LEVEL"
+
+// WatchEnvLevel polls the FLOGG_LOG_LEVEL environment variable every interval and updates the minimum level
+// whenever its value changes, so verbosity can be raised without changing code.
+// Values are parsed with LogLevel.UnmarshalText; invalid values are reported as a warning and ignored.
+// Each logger runs its own watch, so several loggers can poll at different intervals.
+// Calling it again replaces the previous watch; StopEnvLevelWatch or Close stop it.
+func (l *FileLogger) WatchEnvLevel(interval time.Duration) {
+	l.StopEnvLevelWatch()
+
+	stop := make(chan struct{})
+	l.mu.Lock()
+	l.envWatchStop = stop
+	l.mu.Unlock()
+
+	l.goBackground(func(done <-chan struct{}) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var last string
+		for {
+			if value := os.Getenv(EnvLevelVar); value != last {
+				last = value
+				l.applyEnvLevel(value)
+			}
+
+			select {
+			case <-done:
+				return
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// StopEnvLevelWatch stops the watch started by WatchEnvLevel; the current minimum level is kept.
+func (l *FileLogger) StopEnvLevelWatch() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.envWatchStop != nil {
+		close(l.envWatchStop)
+		l.envWatchStop = nil
+	}
+}
+
+func (l *FileLogger) applyEnvLevel(value string) {
+	if value == "" {
+		return
+	}
+
+	var level LogLevel
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		l.LogWarn(fmt.Sprintf("ignoring %s: %s", EnvLevelVar, err.Error()))
+		return
+	}
+	l.SetMinLevel(level)
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitForLevel polls the minimum level of logger until it equals expected or the timeout expires.
+func waitForLevel(logger *FileLogger, expected LogLevel, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if logger.MinLevel() == expected {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return logger.MinLevel() == expected
+}
+
+func TestWatchEnvLevel(t *testing.T) {
+	captureConsole(t)
+	t.Setenv(EnvLevelVar, "error")
+	logger := newTestLogger(t)
+
+	logger.WatchEnvLevel(10 * time.Millisecond)
+	if !waitForLevel(logger, LogLevelError, 50*time.Millisecond) {
+		t.Fatalf("expected min level %s; got %s", LogLevelError, logger.MinLevel())
+	}
+
+	t.Setenv(EnvLevelVar, "debug")
+	if !waitForLevel(logger, LogLevelDebug, 50*time.Millisecond) {
+		t.Fatalf("expected min level %s; got %s", LogLevelDebug, logger.MinLevel())
+	}
+
+	t.Setenv(EnvLevelVar, "loud")
+	time.Sleep(30 * time.Millisecond)
+	if logger.MinLevel() != LogLevelDebug {
+		t.Errorf("expected an invalid value to keep %s; got %s", LogLevelDebug, logger.MinLevel())
+	}
+	if content := readLogFile(t, logger); !strings.Contains(content, "WARNING ignoring FLOGG_LOG_LEVEL") {
+		t.Errorf("expected a warning about the invalid value; got %q", content)
+	}
+}
+
+func TestStopEnvLevelWatch(t *testing.T) {
+	t.Setenv(EnvLevelVar, "warn")
+	logger := newTestLogger(t)
+
+	logger.WatchEnvLevel(10 * time.Millisecond)
+	if !waitForLevel(logger, LogLevelWarn, 50*time.Millisecond) {
+		t.Fatalf("expected min level %s; got %s", LogLevelWarn, logger.MinLevel())
+	}
+	logger.StopEnvLevelWatch()
+
+	t.Setenv(EnvLevelVar, "info")
+	time.Sleep(30 * time.Millisecond)
+	if logger.MinLevel() != LogLevelWarn {
+		t.Errorf("expected the level to stay %s after stopping; got %s", LogLevelWarn, logger.MinLevel())
+	}
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"database/sql"
+	"errors"
+	"io/fs"
+)
+
+type errorLevelRule struct {
+	predicate func(error) bool
+	level     LogLevel
+}
+
+// RegisterErrorLevel makes LogError, LogErrorCtx, LogHTTPError and LogResponseError log the errors matching predicate
+// at level instead of ERROR, e.g. to log validation errors at INFO level. Rules are tried in registration order and the first match wins.
+// Predicates may be called concurrently and must be safe for concurrent use.
+//
+//	l.RegisterErrorLevel(logger.IsNotFoundError, logger.LogLevelInfo)
+//	l.RegisterErrorLevel(logger.IsTemporaryError, logger.LogLevelWarn)
+func (l *FileLogger) RegisterErrorLevel(predicate func(error) bool, level LogLevel) {
+	l.errorLevelsMu.Lock()
+	defer l.errorLevelsMu.Unlock()
+
+	l.errorLevels = append(l.errorLevels, errorLevelRule{predicate: predicate, level: level})
+}
+
+// ClearErrorLevelRules removes all the rules registered with RegisterErrorLevel.
+func (l *FileLogger) ClearErrorLevelRules() {
+	l.errorLevelsMu.Lock()
+	defer l.errorLevelsMu.Unlock()
+
+	l.errorLevels = nil
+}
+
+// errorLevel returns the level of the first rule matching err, or LogLevelError if none matches.
+func (l *FileLogger) errorLevel(err error) LogLevel {
+	l.errorLevelsMu.RLock()
+	rules := l.errorLevels
+	l.errorLevelsMu.RUnlock()
+
+	for _, rule := range rules {
+		if rule.predicate(err) {
+			return rule.level
+		}
+	}
+	return LogLevelError
+}
+
+// IsTemporaryError reports whether err, or an error it wraps, has a Temporary or Timeout method returning true,
+// as network errors do.
+func IsTemporaryError(err error) bool {
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
+
+// IsNotFoundError reports whether err is or wraps fs.ErrNotExist or sql.ErrNoRows,
+// or an error with a NotFound method returning true.
+func IsNotFoundError(err error) bool {
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, sql.ErrNoRows) {
+		return true
+	}
+	var notFound interface{ NotFound() bool }
+	return errors.As(err, &notFound) && notFound.NotFound()
+}
@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+type validationError struct{ field string }
+
+func (e validationError) Error() string { return fmt.Sprintf("invalid %s", e.field) }
+
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "try again" }
+func (temporaryError) Temporary() bool { return true }
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "deadline exceeded" }
+func (timeoutError) Timeout() bool { return true }
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string  { return "no such user" }
+func (notFoundError) NotFound() bool { return true }
+
+var errSentinel = errors.New("already processed")
+
+func TestRegisterErrorLevel(t *testing.T) {
+	isValidation := func(err error) bool {
+		var v validationError
+		return errors.As(err, &v)
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected LogLevel
+	}{
+		{name: "no matching rule", err: errors.New("boom"), expected: LogLevelError},
+		{name: "validation error", err: validationError{field: "email"}, expected: LogLevelInfo},
+		{name: "wrapped validation error", err: fmt.Errorf("signup: %w", validationError{field: "name"}), expected: LogLevelInfo},
+		{name: "sentinel error", err: fmt.Errorf("job 7: %w", errSentinel), expected: LogLevelWarn},
+		{name: "first match wins", err: errors.Join(errSentinel, validationError{field: "id"}), expected: LogLevelInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+			logger.RegisterErrorLevel(isValidation, LogLevelInfo)
+			logger.RegisterErrorLevel(func(err error) bool { return errors.Is(err, errSentinel) }, LogLevelWarn)
+
+			logger.LogError(tt.err)
+
+			expected := fmt.Sprintf("%s %s", tt.expected, tt.err.Error())
+			if content := readLogFile(t, logger); !strings.Contains(content, expected) {
+				t.Errorf("expected %q; got %q", expected, content)
+			}
+		})
+	}
+}
+
+func TestClearErrorLevelRules(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.RegisterErrorLevel(func(error) bool { return true }, LogLevelDebug)
+	logger.ClearErrorLevelRules()
+
+	logger.LogError(errors.New("boom"))
+	if content := readLogFile(t, logger); !strings.Contains(content, "ERROR boom") {
+		t.Errorf("expected the error at ERROR level; got %q", content)
+	}
+}
+
+func TestIsTemporaryError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "temporary", err: temporaryError{}, expected: true},
+		{name: "timeout", err: timeoutError{}, expected: true},
+		{name: "wrapped", err: fmt.Errorf("dial: %w", temporaryError{}), expected: true},
+		{name: "plain error", err: errors.New("boom"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTemporaryError(tt.err); got != tt.expected {
+				t.Errorf("expected %t; got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	_, statErr := os.Stat("does-not-exist")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "missing file", err: statErr, expected: true},
+		{name: "no rows", err: fmt.Errorf("get user: %w", sql.ErrNoRows), expected: true},
+		{name: "NotFound method", err: notFoundError{}, expected: true},
+		{name: "plain error", err: errors.New("boom"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFoundError(tt.err); got != tt.expected {
+				t.Errorf("expected %t; got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotSupported is returned by features that are not available on the current platform, such as NewWindowsEventLogger.
+var ErrNotSupported = errors.New("not supported on this platform")
+
+// eventLogOutputName is the name of the named output that writes to the Windows Event Log.
+const eventLogOutputName = "eventlog"
+
+// parseOutputLine splits a line written to a named output, `<timestamp> <LEVEL> <message>`, into its level and message.
+func parseOutputLine(line string) (LogLevel, string, bool) {
+	line = strings.TrimSuffix(line, "\n")
+	if len(line) < timestampLength {
+		return 0, "", false
+	}
+
+	token, message, _ := strings.Cut(line[timestampLength:], " ")
+	var level LogLevel
+	if err := level.UnmarshalText([]byte(token)); err != nil {
+		return 0, "", false
+	}
+	return level, message, true
+}
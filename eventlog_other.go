@@ -0,0 +1,8 @@
+//go:build !windows
+
+package logger
+
+// NewWindowsEventLogger returns ErrNotSupported outside Windows.
+func NewWindowsEventLogger(source string, minLevel LogLevel) (*FileLogger, error) {
+	return nil, ErrNotSupported
+}
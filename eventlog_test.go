@@ -0,0 +1,28 @@
+package logger
+
+import "testing"
+
+func TestParseOutputLine(t *testing.T) {
+	tests := []struct {
+		name            string
+		line            string
+		expectedLevel   LogLevel
+		expectedMessage string
+		expectedOK      bool
+	}{
+		{name: "info", line: "2025/01/02 15:04:05 INFO server started\n", expectedLevel: LogLevelInfo, expectedMessage: "server started", expectedOK: true},
+		{name: "warning", line: "2025/01/02 15:04:05 WARNING disk at 80%\n", expectedLevel: LogLevelWarn, expectedMessage: "disk at 80%", expectedOK: true},
+		{name: "emergency", line: "2025/01/02 15:04:05 EMERGENCY down", expectedLevel: LogLevelEmergency, expectedMessage: "down", expectedOK: true},
+		{name: "unknown level", line: "2025/01/02 15:04:05 NOTICE hello\n"},
+		{name: "too short", line: "INFO hi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, message, ok := parseOutputLine(tt.line)
+			if ok != tt.expectedOK || level != tt.expectedLevel || message != tt.expectedMessage {
+				t.Errorf("expected %s %q %t; got %s %q %t", tt.expectedLevel, tt.expectedMessage, tt.expectedOK, level, message, ok)
+			}
+		})
+	}
+}
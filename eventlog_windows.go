@@ -0,0 +1,85 @@
+//go:build windows
+
+package logger
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/sys/windows/svc/eventlog"
+)
+
+// eventLogEventID is the event ID of every entry written to the Event Log.
+const eventLogEventID = 1
+
+// NewWindowsEventLogger creates a FileLogger that writes the entries at or above minLevel to the Windows Event Log
+// under source, which must already be registered, e.g. with eventlog.InstallAsEventCreate. Debug and info entries are
+// written as information events, warnings as warning events and anything above as error events.
+// The entries are also written to log files in `user_home_dir/[source]/logs` as a fallback.
+func NewWindowsEventLogger(source string, minLevel LogLevel) (*FileLogger, error) {
+	elog, err := eventlog.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening event log: %w", err)
+	}
+
+	logDir, err := userLogDir(source)
+	if err != nil {
+		elog.Close()
+		return nil, err
+	}
+	l, err := newLogger(false, logDir, WithMinLevel(minLevel))
+	if err != nil {
+		elog.Close()
+		return nil, err
+	}
+
+	w := &eventLogWriter{log: elog}
+	l.AddNamedOutput(OutputConfig{Name: eventLogOutputName, Writer: w, MinLevel: minLevel})
+	l.goBackground(func(done <-chan struct{}) {
+		<-done
+		w.close()
+	})
+	return l, nil
+}
+
+type eventLogWriter struct {
+	mu     sync.Mutex
+	log    *eventlog.Log
+	closed bool
+}
+
+func (w *eventLogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return len(p), nil
+	}
+
+	level, message, ok := parseOutputLine(string(p))
+	if !ok {
+		level, message = LogLevelInfo, string(p)
+	}
+
+	var err error
+	switch {
+	case level >= LogLevelError:
+		err = w.log.Error(eventLogEventID, message)
+	case level == LogLevelWarn:
+		err = w.log.Warning(eventLogEventID, message)
+	default:
+		err = w.log.Info(eventLogEventID, message)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *eventLogWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+	w.log.Close()
+}
@@ -0,0 +1,38 @@
+//go:build windows
+
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/sys/windows/svc/eventlog"
+)
+
+func TestWindowsEventLogger(t *testing.T) {
+	const source = "flogg-test"
+	if err := eventlog.InstallAsEventCreate(source, eventlog.Info|eventlog.Warning|eventlog.Error); err != nil {
+		t.Skipf("failed to register event source, which requires administrator rights: %s", err)
+	}
+	defer eventlog.Remove(source)
+
+	captureConsole(t)
+	logger, err := NewWindowsEventLogger(source, LogLevelDebug)
+	if err != nil {
+		t.Fatalf("failed to create event logger: %s", err)
+	}
+	defer logger.Close()
+
+	logger.LogDebug("debug entry")
+	logger.LogInfo("info entry")
+	logger.LogWarn("warning entry")
+	logger.LogError(errors.New("error entry"))
+
+	stats := logger.NamedOutputStats()[eventLogOutputName]
+	if stats.Messages != 4 {
+		t.Errorf("expected 4 event log entries; got %d", stats.Messages)
+	}
+	if content := readLogFile(t, logger); content == "" {
+		t.Errorf("expected the entries in the fallback log file")
+	}
+}
@@ -0,0 +1,156 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldLogger is a child logger that appends a fixed set of key=value fields to every message.
+// It writes through the logger it was created from, so it shares its files and rotation.
+type FieldLogger struct {
+	logger Logger
+	fields map[string]interface{}
+}
+
+// WithField returns a child logger that appends key=value to every message.
+func (l *FileLogger) WithField(key string, value interface{}) *FieldLogger {
+	return &FieldLogger{logger: l, fields: map[string]interface{}{key: value}}
+}
+
+// WithRequestID returns a child logger that appends the request_id field to every message.
+func (l *FileLogger) WithRequestID(id string) *FieldLogger {
+	return l.WithField("request_id", id)
+}
+
+// WithUserID returns a child logger that appends the user_id field to every message.
+func (l *FileLogger) WithUserID(id string) *FieldLogger {
+	return l.WithField("user_id", id)
+}
+
+// WithTraceID returns a child logger that appends the trace_id field to every message.
+func (l *FileLogger) WithTraceID(id string) *FieldLogger {
+	return l.WithField("trace_id", id)
+}
+
+// WithSessionID returns a child logger that appends the session_id field to every message.
+func (l *FileLogger) WithSessionID(id string) *FieldLogger {
+	return l.WithField("session_id", id)
+}
+
+// WithCorrelationID returns a child logger that appends the correlation_id field to every message.
+func (l *FileLogger) WithCorrelationID(id string) *FieldLogger {
+	return l.WithField("correlation_id", id)
+}
+
+// WithField returns a child logger with key=value added to the fields of f.
+func (f *FieldLogger) WithField(key string, value interface{}) *FieldLogger {
+	fields := make(map[string]interface{}, len(f.fields)+1)
+	for k, v := range f.fields {
+		fields[k] = v
+	}
+	fields[key] = value
+	return &FieldLogger{logger: f.logger, fields: fields}
+}
+
+func (f *FieldLogger) WithRequestID(id string) *FieldLogger {
+	return f.WithField("request_id", id)
+}
+
+func (f *FieldLogger) WithUserID(id string) *FieldLogger {
+	return f.WithField("user_id", id)
+}
+
+func (f *FieldLogger) WithTraceID(id string) *FieldLogger {
+	return f.WithField("trace_id", id)
+}
+
+func (f *FieldLogger) WithSessionID(id string) *FieldLogger {
+	return f.WithField("session_id", id)
+}
+
+func (f *FieldLogger) WithCorrelationID(id string) *FieldLogger {
+	return f.WithField("correlation_id", id)
+}
+
+func (f *FieldLogger) LogFatal(err error) {
+	f.logger.LogFatal(fmt.Errorf("%w %s", err, formatFields(f.fields)))
+}
+
+func (f *FieldLogger) LogError(err error) {
+	f.logger.LogError(fmt.Errorf("%w %s", err, formatFields(f.fields)))
+}
+
+func (f *FieldLogger) LogWarn(message string) {
+	f.logger.LogWarn(f.withFields(message))
+}
+
+func (f *FieldLogger) LogInfo(message string) {
+	f.logger.LogInfo(f.withFields(message))
+}
+
+func (f *FieldLogger) LogDebug(message string) {
+	f.logger.LogDebug(f.withFields(message))
+}
+
+func (f *FieldLogger) LogTrace(message string) {
+	f.logger.LogTrace(f.withFields(message))
+}
+
+func (f *FieldLogger) LogBytes(level LogLevel, label string, data []byte) {
+	f.logger.LogBytes(level, f.withFields(label), data)
+}
+
+func (f *FieldLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	merged := make(map[string]interface{}, len(f.fields)+len(extra))
+	for k, v := range f.fields {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	f.logger.LogChange(field, from, to, merged)
+}
+
+func (f *FieldLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	f.logger.LogDiff(level, f.withFields(label), before, after)
+}
+
+func (f *FieldLogger) LogFatalf(format string, args ...interface{}) {
+	f.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (f *FieldLogger) LogErrorf(format string, args ...interface{}) {
+	f.LogError(fmt.Errorf(format, args...))
+}
+
+func (f *FieldLogger) LogWarnf(format string, args ...interface{}) {
+	f.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (f *FieldLogger) LogInfof(format string, args ...interface{}) {
+	f.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (f *FieldLogger) LogDebugf(format string, args ...interface{}) {
+	f.LogDebug(fmt.Sprintf(format, args...))
+}
+
+func (f *FieldLogger) withFields(message string) string {
+	return fmt.Sprintf("%s %s", message, formatFields(f.fields))
+}
+
+// formatFields renders fields as space separated key=value pairs sorted by key.
+func formatFields(fields map[string]interface{}) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", k, fields[k])
+	}
+	return strings.Join(pairs, " ")
+}
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFieldLogger(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	child := logger.WithRequestID("req-1").WithUserID("42")
+	child.LogInfo("request received")
+	child.LogWarn("slow query")
+	child.LogError(errors.New("query failed"))
+	child.LogDebug("response sent")
+	logger.LogInfo("no fields")
+
+	content, err := os.ReadFile(logger.CurrentLogFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines; got %d", len(lines))
+	}
+
+	for _, line := range lines[:4] {
+		if !strings.HasSuffix(line, "request_id=req-1 user_id=42") {
+			t.Errorf("expected line to end with the child fields; got %q", line)
+		}
+	}
+	if strings.Contains(lines[4], "request_id") {
+		t.Errorf("expected the parent logger to have no fields; got %q", lines[4])
+	}
+}
+
+func TestFieldLoggerIDs(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	tests := []struct {
+		name     string
+		child    *FieldLogger
+		expected string
+	}{
+		{name: "request id", child: logger.WithRequestID("r1"), expected: "request_id=r1"},
+		{name: "user id", child: logger.WithUserID("u1"), expected: "user_id=u1"},
+		{name: "trace id", child: logger.WithTraceID("t1"), expected: "trace_id=t1"},
+		{name: "session id", child: logger.WithSessionID("s1"), expected: "session_id=s1"},
+		{name: "overridden field", child: logger.WithRequestID("r1").WithRequestID("r2"), expected: "request_id=r2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.child.LogInfo(tt.name)
+
+			content, err := os.ReadFile(logger.CurrentLogFile.Name())
+			if err != nil {
+				t.Fatalf("failed to read log file: %s", err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			last := lines[len(lines)-1]
+			if !strings.HasSuffix(last, tt.name+" "+tt.expected) {
+				t.Errorf("expected line to end with %q; got %q", tt.expected, last)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package logger
+
+import "time"
+
+// Fields builds the fields of a log entry with a chain of typed setters, as an alternative to a map literal:
+//
+//	l.LogTraceWith("cache miss", logger.NewFields().String("key", key).Int("attempt", n).Build())
+type Fields struct {
+	m map[string]interface{}
+}
+
+// NewFields returns an empty Fields.
+func NewFields() *Fields {
+	return &Fields{m: make(map[string]interface{})}
+}
+
+// Set sets key to value, replacing any previous value of key.
+func (f *Fields) Set(key string, value interface{}) *Fields {
+	f.m[key] = value
+	return f
+}
+
+func (f *Fields) String(key, val string) *Fields {
+	return f.Set(key, val)
+}
+
+func (f *Fields) Int(key string, val int) *Fields {
+	return f.Set(key, val)
+}
+
+func (f *Fields) Bool(key string, val bool) *Fields {
+	return f.Set(key, val)
+}
+
+// Dur sets key to d, which is written like time.Duration.String, e.g. 1.5s.
+func (f *Fields) Dur(key string, d time.Duration) *Fields {
+	return f.Set(key, d)
+}
+
+// Err sets the error field to the message of err; a nil err is ignored.
+func (f *Fields) Err(err error) *Fields {
+	if err == nil {
+		return f
+	}
+	return f.Set("error", err.Error())
+}
+
+// Build returns the fields as a map. The map is not copied, so f must not be changed while it is in use.
+func (f *Fields) Build() map[string]interface{} {
+	return f.m
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFields(t *testing.T) {
+	got := NewFields().
+		String("action", "login").
+		Int("user_id", 1).
+		Bool("admin", false).
+		Dur("elapsed", 1500*time.Millisecond).
+		Err(errors.New("bad password")).
+		Err(nil).
+		Set("attempt", 2.5).
+		Build()
+
+	expected := map[string]interface{}{
+		"action":  "login",
+		"user_id": 1,
+		"admin":   false,
+		"elapsed": 1500 * time.Millisecond,
+		"error":   "bad password",
+		"attempt": 2.5,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v; got %v", expected, got)
+	}
+}
+
+func TestLogWithFields(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogCodedErrorWith(409, errors.New("conflict"), NewFields().String("resource", "order").Dur("elapsed", time.Second).Build())
+	logger.LogEmergencyWith("disk full", NewFields().Int("free_mb", 0).Build())
+
+	content := readLogFile(t, logger)
+	for _, expected := range []string{"ERROR [409] conflict elapsed=1s resource=order", "EMERGENCY disk full free_mb=0"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q; got %q", expected, content)
+		}
+	}
+}
+
+func BenchmarkFieldsMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		fields := map[string]interface{}{"user_id": i, "action": "login", "ok": true}
+		_ = formatFields(fields)
+	}
+}
+
+func BenchmarkFieldsBuilder(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		fields := NewFields().Int("user_id", i).String("action", "login").Bool("ok", true).Build()
+		_ = formatFields(fields)
+	}
+}
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// WithFileOpThresholdMs makes LogFileOperation log an additional warning with slow_file_op=true for operations
+// that take longer than ms milliseconds. Slow operations are not detected when ms is 0, the default.
+func WithFileOpThresholdMs(ms int64) Option {
+	return func(l *FileLogger) {
+		l.FileOpThresholdMs = ms
+	}
+}
+
+// LogFileOperation logs a file operation such as "read", "write", "delete" or "rename" as `file_op` with the op, path,
+// bytes, duration_ms and throughput_mbps fields, followed by the extra fields. Failed operations are logged at ERROR
+// level with the error field, and successful ones at DEBUG level. Operations slower than FileOpThresholdMs are
+// logged again at WARNING level with slow_file_op=true.
+func (l *FileLogger) LogFileOperation(op, path string, bytes int64, duration time.Duration, err error, fields map[string]interface{}) {
+	level := LogLevelDebug
+	if err != nil {
+		level = LogLevelError
+	}
+	message := formatFileOperation(op, path, bytes, duration, err, fields)
+	l.logAt(level, message)
+
+	if l.FileOpThresholdMs > 0 && duration.Milliseconds() > l.FileOpThresholdMs {
+		l.logAt(LogLevelWarn, message+" slow_file_op=true")
+	}
+}
+
+func formatFileOperation(op, path string, bytes int64, duration time.Duration, err error, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+6)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["op"] = op
+	fields["path"] = strconv.Quote(path)
+	fields["bytes"] = bytes
+	fields["duration_ms"] = duration.Milliseconds()
+	fields["throughput_mbps"] = fmt.Sprintf("%.2f", fileThroughput(bytes, duration))
+	if err != nil {
+		fields["error"] = strconv.Quote(err.Error())
+	}
+	return fmt.Sprintf("file_op %s", formatFields(fields))
+}
+
+// fileThroughput returns the throughput in megabytes per second, or 0 if nothing was transferred.
+func fileThroughput(bytes int64, duration time.Duration) float64 {
+	if bytes <= 0 || duration <= 0 {
+		return 0
+	}
+	return float64(bytes) / 1e6 / duration.Seconds()
+}
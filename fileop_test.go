@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogFileOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       string
+		path     string
+		bytes    int64
+		duration time.Duration
+		err      error
+		fields   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "read",
+			op:       "read",
+			path:     "/var/data/input.csv",
+			bytes:    10_000_000,
+			duration: 2 * time.Second,
+			expected: `DEBUG file_op bytes=10000000 duration_ms=2000 op=read path="/var/data/input.csv" throughput_mbps=5.00`,
+		},
+		{
+			name:     "write",
+			op:       "write",
+			path:     "/var/data/output.csv",
+			bytes:    500_000,
+			duration: 250 * time.Millisecond,
+			expected: `DEBUG file_op bytes=500000 duration_ms=250 op=write path="/var/data/output.csv" throughput_mbps=2.00`,
+		},
+		{
+			name:     "delete",
+			op:       "delete",
+			path:     "/tmp/old file.log",
+			duration: time.Millisecond,
+			expected: `DEBUG file_op bytes=0 duration_ms=1 op=delete path="/tmp/old file.log" throughput_mbps=0.00`,
+		},
+		{
+			name:     "rename with fields",
+			op:       "rename",
+			path:     "/tmp/a.log",
+			fields:   map[string]interface{}{"to": "/tmp/b.log"},
+			expected: `DEBUG file_op bytes=0 duration_ms=0 op=rename path="/tmp/a.log" throughput_mbps=0.00 to=/tmp/b.log`,
+		},
+		{
+			name:     "write error",
+			op:       "write",
+			path:     "/var/data/output.csv",
+			bytes:    0,
+			duration: 3 * time.Millisecond,
+			err:      errors.New("no space left on device"),
+			expected: `ERROR file_op bytes=0 duration_ms=3 error="no space left on device" op=write path="/var/data/output.csv" throughput_mbps=0.00`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogFileOperation(tt.op, tt.path, tt.bytes, tt.duration, tt.err, tt.fields)
+
+			content := readLogFile(t, logger)
+			if !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+			if strings.Contains(content, "slow_file_op") {
+				t.Errorf("expected no slow_file_op entry without a threshold; got %q", content)
+			}
+		})
+	}
+}
+
+func TestFileOpThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		slow     bool
+	}{
+		{name: "below threshold", duration: 50 * time.Millisecond},
+		{name: "at threshold", duration: 100 * time.Millisecond},
+		{name: "above threshold", duration: 150 * time.Millisecond, slow: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t, WithFileOpThresholdMs(100))
+
+			logger.LogFileOperation("read", "/tmp/a.log", 1024, tt.duration, nil, nil)
+
+			content := readLogFile(t, logger)
+			if !strings.Contains(content, "DEBUG file_op") {
+				t.Errorf("expected the operation at DEBUG level; got %q", content)
+			}
+			if slow := strings.Contains(content, "WARNING file_op") && strings.Contains(content, "slow_file_op=true"); slow != tt.slow {
+				t.Errorf("expected slow entry %t; got %q", tt.slow, content)
+			}
+		})
+	}
+}
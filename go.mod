@@ -1,3 +1,11 @@
 module github.com/agusespa/flogg
 
 go 1.23.2
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.24
+	golang.org/x/sys v0.28.0
+	golang.org/x/term v0.27.0
+	golang.org/x/text v0.21.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// loggerState is the part of a FileLogger encoded by GobEncode.
+type loggerState struct {
+	LogDir     string
+	DevMode    bool
+	MinLevel   LogLevel
+	Rotation   RotationPolicy
+	SeqPadding int
+	Prefix     string
+	// MaxFileSizeBytes is the size limit of the log files; zero disables size based rotation.
+	MaxFileSizeBytes int64
+	// Period and Seq identify the active log file, e.g. 2025-1-15 and 3 for 2025-1-15_3.log.
+	Period string
+	Seq    int
+}
+
+// GobEncode encodes the log directory, mode, minimum level, rotation policy, sequence number padding, prefix, file
+// size limit and active log file of the logger, so that another node of a cluster can continue its log with GobDecode.
+func (l *FileLogger) GobEncode() ([]byte, error) {
+	state := loggerState{
+		LogDir:           l.LogDir,
+		DevMode:          l.IsDevMode(),
+		MinLevel:         l.MinLevel(),
+		Rotation:         l.Rotation,
+		SeqPadding:       l.SeqPadding,
+		Prefix:           l.Prefix(),
+		MaxFileSizeBytes: l.MaxFileSizeBytes,
+	}
+	if path := l.currentLogPath(); path != "" {
+		filename := filepath.Base(path)
+		period := logFileNamePrefix(filename)
+		if seq, ok := parseLogFileSeq(filename, period, l.SeqPadding); ok {
+			state.Period, state.Seq = period, seq
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(state); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode sets up a new FileLogger, such as new(FileLogger), from the state encoded by GobEncode.
+// It reopens the encoded log file if it belongs to the current day or week, and the latest log file of the current
+// period otherwise. Other settings get their defaults; the logger must be closed like one created by NewLogger.
+func (l *FileLogger) GobDecode(data []byte) error {
+	if l.CurrentLogFile != nil || l.FileLog != nil {
+		return errors.New("cannot decode into a logger in use")
+	}
+
+	var state loggerState
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&state); err != nil {
+		return err
+	}
+	if state.LogDir == "" {
+		return errors.New("missing log directory")
+	}
+	if err := os.MkdirAll(state.LogDir, 0755); err != nil {
+		return fmt.Errorf("failed creating log directory: %w", err)
+	}
+
+	l.DevMode = state.DevMode
+	l.LogDir = state.LogDir
+	l.ColorScheme = DefaultColorScheme()
+	l.ChangeMinLevel = LogLevelInfo
+	l.MaxFileSizeBytes = state.MaxFileSizeBytes
+	l.Rotation = state.Rotation
+	l.SeqPadding = state.SeqPadding
+	l.prefix = state.Prefix
+	l.SetMinLevel(state.MinLevel)
+
+	return l.start(func() (*os.File, error) {
+		if state.Seq == 0 || state.Period != logFilePeriod(time.Now(), l.Rotation) {
+			return getUserLogFile(l.LogDir, l.SeqPadding, l.Rotation, l.filenamePID())
+		}
+		path := filepath.Join(l.LogDir, formatLogFileName(state.Period, state.Seq, l.SeqPadding))
+		return os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	})
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGobEncodeDecode(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMaxLinesPerFile(2), WithSeqPadding(3), WithMinLevel(LogLevelWarn), WithPrefix("[node1] "), WithMaxFileSizeBytes(4096))
+	for i := 0; i < 5; i++ {
+		logger.LogWarn("before")
+	}
+	active := logger.CurrentLogFile.Name()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(logger); err != nil {
+		t.Fatalf("failed to encode logger: %s", err)
+	}
+	logger.Close()
+
+	var decoded *FileLogger
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("failed to decode logger: %s", err)
+	}
+	defer decoded.Close()
+
+	if decoded.CurrentLogFile.Name() != active {
+		t.Errorf("expected the active file %s; got %s", active, decoded.CurrentLogFile.Name())
+	}
+	if decoded.MinLevel() != LogLevelWarn || decoded.SeqPadding != 3 || decoded.Prefix() != "[node1] " || decoded.MaxFileSizeBytes != 4096 {
+		t.Errorf("expected the encoded settings; got level %s, padding %d, prefix %q, max size %d",
+			decoded.MinLevel(), decoded.SeqPadding, decoded.Prefix(), decoded.MaxFileSizeBytes)
+	}
+
+	decoded.LogWarn("after")
+	content, err := os.ReadFile(active)
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	if !strings.Contains(string(content), "[node1] WARNING before") || !strings.Contains(string(content), "[node1] WARNING after") {
+		t.Errorf("expected the decoded logger to continue the active file; got %q", content)
+	}
+}
+
+func TestGobDecodePreviousPeriod(t *testing.T) {
+	captureConsole(t)
+	logDir := t.TempDir()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(loggerState{LogDir: logDir, Period: "2000-1-1", Seq: 4}); err != nil {
+		t.Fatalf("failed to encode state: %s", err)
+	}
+
+	logger := new(FileLogger)
+	if err := logger.GobDecode(buf.Bytes()); err != nil {
+		t.Fatalf("failed to decode logger: %s", err)
+	}
+	defer logger.Close()
+
+	expected := formatLogFileName(logFilePeriod(time.Now(), RotateDaily), 1, 0)
+	if name := filepath.Base(logger.CurrentLogFile.Name()); name != expected {
+		t.Errorf("expected %s for a past period; got %s", expected, name)
+	}
+}
+
+func TestGobDecodeInUse(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	data, err := logger.GobEncode()
+	if err != nil {
+		t.Fatalf("failed to encode logger: %s", err)
+	}
+
+	if err := logger.GobDecode(data); err == nil {
+		t.Errorf("expected an error decoding into a logger in use")
+	}
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// LogGoroutineCount logs the number of goroutines at DEBUG level as `goroutines count=N delta=D`,
+// where delta is the change since the previous observation of the logger, or 0 for the first one.
+func (l *FileLogger) LogGoroutineCount() {
+	l.logGoroutineCount(0)
+}
+
+// StartGoroutineCountLogger calls LogGoroutineCount every interval, logging at WARN level instead when the count
+// exceeds warnThreshold, to help spot goroutine leaks. A warnThreshold of 0 disables the warning.
+// Calling it again replaces the previous logger; StopGoroutineCountLogger or Close stop it.
+func (l *FileLogger) StartGoroutineCountLogger(interval time.Duration, warnThreshold int) {
+	l.StopGoroutineCountLogger()
+
+	stop := make(chan struct{})
+	l.mu.Lock()
+	l.goroutineCountStop = stop
+	l.mu.Unlock()
+
+	l.goBackground(func(done <-chan struct{}) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-stop:
+				return
+			case <-ticker.C:
+				l.logGoroutineCount(warnThreshold)
+			}
+		}
+	})
+}
+
+// StopGoroutineCountLogger stops the logger started by StartGoroutineCountLogger.
+func (l *FileLogger) StopGoroutineCountLogger() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.goroutineCountStop != nil {
+		close(l.goroutineCountStop)
+		l.goroutineCountStop = nil
+	}
+}
+
+func (l *FileLogger) logGoroutineCount(warnThreshold int) {
+	count := runtime.NumGoroutine()
+	var delta int64
+	if last := l.lastGoroutines.Swap(int64(count)); last > 0 {
+		delta = int64(count) - last
+	}
+
+	level := LogLevelDebug
+	if warnThreshold > 0 && count > warnThreshold {
+		level = LogLevelWarn
+	}
+	l.logAt(level, fmt.Sprintf("goroutines count=%d delta=%d", count, delta))
+}
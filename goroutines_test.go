@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogGoroutineCount(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogGoroutineCount()
+	stop := make(chan struct{})
+	for i := 0; i < 5; i++ {
+		go func() { <-stop }()
+	}
+	logger.LogGoroutineCount()
+	close(stop)
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines; got %q", lines)
+	}
+	if !regexp.MustCompile(`DEBUG goroutines count=\d+ delta=0$`).MatchString(lines[0]) {
+		t.Errorf("expected a zero delta for the first observation; got %q", lines[0])
+	}
+	if !regexp.MustCompile(`DEBUG goroutines count=\d+ delta=[5-9]$`).MatchString(lines[1]) {
+		t.Errorf("expected the new goroutines in the delta; got %q", lines[1])
+	}
+}
+
+func TestStartGoroutineCountLogger(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	for i := 0; i < 10; i++ {
+		go func() { <-stop }()
+	}
+
+	logger.StartGoroutineCountLogger(20*time.Millisecond, 5)
+	time.Sleep(50 * time.Millisecond)
+	logger.StopGoroutineCountLogger()
+	time.Sleep(10 * time.Millisecond)
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "WARNING goroutines count=") {
+		t.Errorf("expected a warning above the threshold; got %q", content)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if readLogFile(t, logger) != content {
+		t.Errorf("expected no more counts after StopGoroutineCountLogger")
+	}
+}
@@ -0,0 +1,124 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+)
+
+// LogGroup buffers log entries in memory until they are committed to the logger as one block or discarded.
+// It implements Logger, so it can be passed to code that logs a transaction that should only be kept if it fails.
+type LogGroup struct {
+	logger  *FileLogger
+	id      string
+	mu      sync.Mutex
+	entries []groupEntry
+}
+
+type groupEntry struct {
+	level   LogLevel
+	message string
+}
+
+// StartGroup returns an empty LogGroup identified by id that commits to the logger.
+func (l *FileLogger) StartGroup(id string) *LogGroup {
+	return &LogGroup{logger: l, id: id}
+}
+
+// Commit writes the buffered entries to the log file in order, as a single block between
+// group_start and group_end markers, and empties the group.
+func (g *LogGroup) Commit() {
+	g.mu.Lock()
+	entries := g.entries
+	g.entries = nil
+	g.mu.Unlock()
+
+	messages := make([]string, 0, len(entries)+2)
+	messages = append(messages, fmt.Sprintf("%s group_start id=%s entries=%d", LogLevelInfo, g.id, len(entries)))
+	for _, entry := range entries {
+		messages = append(messages, fmt.Sprintf("%s %s", entry.level, entry.message))
+	}
+	messages = append(messages, fmt.Sprintf("%s group_end id=%s", LogLevelInfo, g.id))
+	g.logger.logToFile(messages...)
+
+	for i, entry := range entries {
+		g.logger.logToOutputs(entry.level, messages[i+1])
+		g.logger.logToConsole(entry.level, entry.message)
+	}
+}
+
+// Rollback discards the buffered entries without writing them.
+func (g *LogGroup) Rollback() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.entries = nil
+}
+
+// LogFatal buffers the error and commits the group right away, since the program terminates afterwards.
+func (g *LogGroup) LogFatal(err error) {
+	g.add(LogLevelFatal, err.Error())
+	g.Commit()
+}
+
+func (g *LogGroup) LogError(err error) {
+	g.add(LogLevelError, err.Error())
+}
+
+func (g *LogGroup) LogWarn(message string) {
+	g.add(LogLevelWarn, message)
+}
+
+func (g *LogGroup) LogInfo(message string) {
+	g.add(LogLevelInfo, message)
+}
+
+func (g *LogGroup) LogDebug(message string) {
+	g.add(LogLevelDebug, message)
+}
+
+func (g *LogGroup) LogBytes(level LogLevel, label string, data []byte) {
+	g.add(level, formatBytes(label, data, g.logger.MaxBytesLogged))
+}
+
+func (g *LogGroup) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	g.add(g.logger.ChangeMinLevel, formatChange(field, from, to, extra))
+}
+
+func (g *LogGroup) LogDiff(level LogLevel, label string, before, after interface{}) {
+	g.add(level, formatDiff(label, before, after))
+}
+
+func (g *LogGroup) LogFatalf(format string, args ...interface{}) {
+	g.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (g *LogGroup) LogErrorf(format string, args ...interface{}) {
+	g.LogError(fmt.Errorf(format, args...))
+}
+
+func (g *LogGroup) LogWarnf(format string, args ...interface{}) {
+	g.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (g *LogGroup) LogInfof(format string, args ...interface{}) {
+	g.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (g *LogGroup) LogDebugf(format string, args ...interface{}) {
+	g.LogDebug(fmt.Sprintf(format, args...))
+}
+
+func (g *LogGroup) LogTrace(message string) {
+	g.add(LogLevelTrace, message)
+}
+
+func (g *LogGroup) add(level LogLevel, message string) {
+	if !g.logger.enabled(level) {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.entries = append(g.entries, groupEntry{level: level, message: message})
+}
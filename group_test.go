@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLogGroupCommit(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	group := logger.StartGroup("saga-1")
+	group.LogInfo("reserve stock")
+	group.LogWarn("payment retried")
+	logger.LogInfo("outside the group")
+	group.LogError(errors.New("shipping failed"))
+	group.Commit()
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	expected := []string{
+		"INFO outside the group",
+		"INFO group_start id=saga-1 entries=3",
+		"INFO reserve stock",
+		"WARNING payment retried",
+		"ERROR shipping failed",
+		"INFO group_end id=saga-1",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines; got %d: %q", len(expected), len(lines), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("expected line %d to end with %q; got %q", i, expected[i], line)
+		}
+	}
+}
+
+func TestLogGroupRollback(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	group := logger.StartGroup("saga-2")
+	group.LogInfo("reserve stock")
+	group.LogDebug("stock reserved")
+	group.Rollback()
+
+	if content := readLogFile(t, logger); content != "" {
+		t.Errorf("expected nothing written after Rollback; got %q", content)
+	}
+
+	group.LogInfo("second attempt")
+	group.Commit()
+	content := readLogFile(t, logger)
+	if strings.Contains(content, "reserve stock") || !strings.Contains(content, "second attempt") {
+		t.Errorf("expected only the entries logged after Rollback; got %q", content)
+	}
+}
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+)
+
+// Heartbeat logs a heartbeat line at INFO level every interval until the logger is closed.
+// fn is called at each beat and its fields are appended to the line, so it can report live metrics.
+// Heartbeat can be called several times to run independent heartbeats.
+func (l *FileLogger) Heartbeat(interval time.Duration, fn func() map[string]interface{}) {
+	l.goBackground(func(done <-chan struct{}) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				message := "heartbeat"
+				if fields := fn(); len(fields) > 0 {
+					message = fmt.Sprintf("%s %s", message, formatFields(fields))
+				}
+				l.LogInfo(message)
+			}
+		}
+	})
+}
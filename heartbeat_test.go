@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHeartbeat(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var beats atomic.Int64
+	logger.Heartbeat(50*time.Millisecond, func() map[string]interface{} {
+		return map[string]interface{}{"beat": beats.Add(1)}
+	})
+	logger.Heartbeat(50*time.Millisecond, func() map[string]interface{} {
+		return map[string]interface{}{"name": "second"}
+	})
+
+	time.Sleep(200 * time.Millisecond)
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+	count := beats.Load()
+
+	content := readLogFile(t, logger)
+	if n := strings.Count(content, "INFO heartbeat beat="); n < 3 {
+		t.Errorf("expected at least 3 heartbeats within 200ms; got %d", n)
+	}
+	if !strings.Contains(content, "INFO heartbeat name=second") {
+		t.Errorf("expected the second heartbeat to be logged")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if beats.Load() != count {
+		t.Errorf("expected the heartbeat to stop after Close")
+	}
+}
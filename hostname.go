@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+)
+
+// hostname returns the name of the host; it is a variable so that tests can simulate failures.
+var hostname = os.Hostname
+
+// WithHostname writes the host name as `[hostname]` in front of the prefix of every log file line,
+// so that logs collected from several machines can be told apart. The name is looked up once, when the logger
+// is created; if that fails, "unknown" is used and a warning is logged.
+func WithHostname(enabled bool) Option {
+	return func(l *FileLogger) {
+		l.IncludeHostname = enabled
+	}
+}
+
+// resolveHostname caches the host name if IncludeHostname is set, returning the lookup error, if any.
+func (l *FileLogger) resolveHostname() error {
+	if !l.IncludeHostname {
+		return nil
+	}
+
+	name, err := hostname()
+	if err != nil {
+		l.hostname = "unknown"
+		return err
+	}
+	l.hostname = name
+	return nil
+}
+
+// linePrefix returns the full prefix written between the timestamp and the level of log file lines.
+func (l *FileLogger) linePrefix() string {
+	if l.hostname == "" {
+		return l.prefix
+	}
+	return fmt.Sprintf("[%s] %s", l.hostname, l.prefix)
+}
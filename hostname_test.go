@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWithHostname(t *testing.T) {
+	captureConsole(t)
+	name, err := os.Hostname()
+	if err != nil {
+		t.Skipf("failed to get hostname: %s", err)
+	}
+
+	logger := newTestLogger(t, WithHostname(true), WithPrefix("api "))
+	logger.LogInfo("request served")
+
+	expected := "[" + name + "] api INFO request served\n"
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, expected) {
+		t.Errorf("expected line ending with %q; got %q", expected, content)
+	}
+	if logger.Prefix() != "api " {
+		t.Errorf("expected the prefix to exclude the hostname; got %q", logger.Prefix())
+	}
+}
+
+func TestWithHostnameFallback(t *testing.T) {
+	captureConsole(t)
+	original := hostname
+	hostname = func() (string, error) { return "", errors.New("no hostname") }
+	t.Cleanup(func() { hostname = original })
+
+	logger := newTestLogger(t, WithHostname(true))
+	logger.LogInfo("request served")
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "[unknown] WARNING failed getting hostname: no hostname\n") {
+		t.Errorf("expected a warning about the hostname; got %q", content)
+	}
+	if !strings.HasSuffix(content, "[unknown] INFO request served\n") {
+		t.Errorf("expected the unknown hostname in front of the entries; got %q", content)
+	}
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// WithMaxHTTPBodyLogBytes caps the number of body bytes logged by LogHTTPBody, whatever the maxBytes argument.
+// Bodies are only cut off at maxBytes when n is 0, the default.
+func WithMaxHTTPBodyLogBytes(n int) Option {
+	return func(l *FileLogger) {
+		l.MaxHTTPBodyLogBytes = n
+	}
+}
+
+// LogHTTPBody logs an HTTP request or response body at DEBUG level as `http_body` with the direction, content_type
+// and length fields, followed by the extra fields and the body on the next lines. Text bodies, such as JSON, XML and
+// form data, are logged as is, with JSON pretty-printed in DevMode; other bodies are hex encoded. Bodies longer than
+// maxBytes, or MaxHTTPBodyLogBytes if lower, are cut off and followed by a note with the total length.
+func (l *FileLogger) LogHTTPBody(direction, contentType string, body []byte, maxBytes int, fields map[string]interface{}) {
+	if !l.enabled(LogLevelDebug) {
+		return
+	}
+	if l.MaxHTTPBodyLogBytes > 0 && (maxBytes <= 0 || maxBytes > l.MaxHTTPBodyLogBytes) {
+		maxBytes = l.MaxHTTPBodyLogBytes
+	}
+	l.logAt(LogLevelDebug, formatHTTPBody(direction, contentType, body, maxBytes, l.IsDevMode(), fields))
+}
+
+func formatHTTPBody(direction, contentType string, body []byte, maxBytes int, pretty bool, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+3)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["direction"] = direction
+	fields["content_type"] = fmt.Sprintf("%q", contentType)
+	fields["length"] = len(body)
+
+	logged := body
+	truncated := maxBytes > 0 && len(body) > maxBytes
+	if truncated {
+		logged = body[:maxBytes]
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "http_body %s", formatFields(fields))
+	if len(logged) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(formatHTTPBodyContent(contentType, logged, pretty && !truncated))
+	}
+	if truncated {
+		fmt.Fprintf(&sb, "\n[truncated: %d bytes total]", len(body))
+	}
+	return sb.String()
+}
+
+func formatHTTPBodyContent(contentType string, body []byte, pretty bool) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if isJSONMediaType(mediaType) && pretty {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, body, "", "  "); err == nil {
+			return indented.String()
+		}
+	}
+	if isTextMediaType(mediaType) {
+		return string(body)
+	}
+	return hex.EncodeToString(body)
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func isTextMediaType(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "text/"), isJSONMediaType(mediaType), strings.HasSuffix(mediaType, "+xml"):
+		return true
+	}
+	switch mediaType {
+	case "application/xml", "application/x-www-form-urlencoded", "application/javascript":
+		return true
+	}
+	return false
+}
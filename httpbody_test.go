@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogHTTPBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		maxBytes    int
+		devMode     bool
+		expected    string
+	}{
+		{
+			name:        "json",
+			contentType: "application/json",
+			body:        `{"id":7,"tags":["a"]}`,
+			expected:    "DEBUG http_body content_type=\"application/json\" direction=outbound length=21\n{\"id\":7,\"tags\":[\"a\"]}\n",
+		},
+		{
+			name:        "json in dev mode",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"id":7}`,
+			devMode:     true,
+			expected:    "DEBUG http_body content_type=\"application/json; charset=utf-8\" direction=outbound length=8\n{\n  \"id\": 7\n}\n",
+		},
+		{
+			name:        "text",
+			contentType: "text/plain",
+			body:        "hello world",
+			expected:    "DEBUG http_body content_type=\"text/plain\" direction=outbound length=11\nhello world\n",
+		},
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "a=1&b=2",
+			expected:    "DEBUG http_body content_type=\"application/x-www-form-urlencoded\" direction=outbound length=7\na=1&b=2\n",
+		},
+		{
+			name:        "binary",
+			contentType: "image/png",
+			body:        "\x89PNG",
+			expected:    "DEBUG http_body content_type=\"image/png\" direction=outbound length=4\n89504e47\n",
+		},
+		{
+			name:        "truncated json in dev mode",
+			contentType: "application/json",
+			body:        `{"id":7,"name":"long"}`,
+			maxBytes:    8,
+			devMode:     true,
+			expected:    "DEBUG http_body content_type=\"application/json\" direction=outbound length=22\n{\"id\":7,\n[truncated: 22 bytes total]\n",
+		},
+		{
+			name:        "empty",
+			contentType: "application/json",
+			expected:    "DEBUG http_body content_type=\"application/json\" direction=outbound length=0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+			logger.SetDevMode(tt.devMode)
+
+			logger.LogHTTPBody("outbound", tt.contentType, []byte(tt.body), tt.maxBytes, nil)
+
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestMaxHTTPBodyLogBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxBytes int
+		expected string
+	}{
+		{name: "global cap below argument", maxBytes: 100, expected: "\nabcd\n[truncated: 10 bytes total]\n"},
+		{name: "argument below global cap", maxBytes: 2, expected: "\nab\n[truncated: 10 bytes total]\n"},
+		{name: "no argument", maxBytes: 0, expected: "\nabcd\n[truncated: 10 bytes total]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t, WithMaxHTTPBodyLogBytes(4))
+
+			logger.LogHTTPBody("inbound", "text/plain", []byte("abcdefghij"), tt.maxBytes, map[string]interface{}{"request_id": "r1"})
+
+			content := readLogFile(t, logger)
+			if !strings.Contains(content, "direction=inbound length=10 request_id=r1") {
+				t.Errorf("expected the extra fields; got %q", content)
+			}
+			if !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// WithHTTPErrorTemplate sets the function that builds the response body written by LogHTTPError and its content type.
+// The default body is JSON of the form {"error":"...","status":N,"request_id":"..."}.
+func WithHTTPErrorTemplate(fn func(statusCode int, err error, requestID string) ([]byte, string)) Option {
+	return func(l *FileLogger) {
+		l.HTTPErrorTemplate = fn
+	}
+}
+
+// LogHTTPError logs an API error at ERROR level as `http_error` with the error, request_id and status fields in
+// addition to fields, and writes the error response with statusCode, so that the client and the log entry share the
+// request ID. The response is JSON unless HTTPErrorTemplate returns another content type. Like LogError, and like
+// LogResponseError which it shares its implementation with, ErrorLevelRules can log err at another level.
+func (l *FileLogger) LogHTTPError(w http.ResponseWriter, statusCode int, err error, requestID string, fields map[string]interface{}) {
+	message := errorResponseMessage(err, statusCode)
+
+	var body []byte
+	var contentType string
+	if l.HTTPErrorTemplate != nil {
+		body, contentType = l.HTTPErrorTemplate(statusCode, err, requestID)
+	} else {
+		body, _ = json.Marshal(struct {
+			Error     string `json:"error"`
+			Status    int    `json:"status"`
+			RequestID string `json:"request_id"`
+		}{Error: message, Status: statusCode, RequestID: requestID})
+	}
+
+	extra := map[string]interface{}{"request_id": requestID}
+	l.respondError(w, "http_error", statusCode, err, message, fields, extra, body, contentType)
+}
@@ -0,0 +1,119 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogHTTPError(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		err          error
+		statusCode   int
+		fields       map[string]interface{}
+		expectedLog  string
+		expectedBody string
+		expectedType string
+	}{
+		{
+			name:         "default body",
+			err:          errors.New("user not found"),
+			statusCode:   http.StatusNotFound,
+			expectedLog:  "ERROR http_error error=\"user not found\" request_id=req-42 status=404\n",
+			expectedBody: `{"error":"user not found","status":404,"request_id":"req-42"}`,
+			expectedType: "application/json",
+		},
+		{
+			name:         "extra fields",
+			err:          errors.New("db timeout"),
+			statusCode:   http.StatusServiceUnavailable,
+			fields:       map[string]interface{}{"user_id": 7},
+			expectedLog:  "ERROR http_error error=\"db timeout\" request_id=req-42 status=503 user_id=7\n",
+			expectedBody: `{"error":"db timeout","status":503,"request_id":"req-42"}`,
+			expectedType: "application/json",
+		},
+		{
+			name:         "nil error",
+			statusCode:   http.StatusUnauthorized,
+			expectedLog:  "ERROR http_error error=\"Unauthorized\" request_id=req-42 status=401\n",
+			expectedBody: `{"error":"Unauthorized","status":401,"request_id":"req-42"}`,
+			expectedType: "application/json",
+		},
+		{
+			name: "custom template",
+			opts: []Option{WithHTTPErrorTemplate(func(statusCode int, err error, requestID string) ([]byte, string) {
+				return []byte(fmt.Sprintf("%d %s (%s)", statusCode, err, requestID)), "text/plain"
+			})},
+			err:          errors.New("invalid id"),
+			statusCode:   http.StatusBadRequest,
+			expectedLog:  "ERROR http_error error=\"invalid id\" request_id=req-42 status=400\n",
+			expectedBody: "400 invalid id (req-42)",
+			expectedType: "text/plain",
+		},
+		{
+			name: "custom template without content type",
+			opts: []Option{WithHTTPErrorTemplate(func(statusCode int, err error, requestID string) ([]byte, string) {
+				return []byte(fmt.Sprintf(`{"code":%d,"id":%q}`, statusCode, requestID)), ""
+			})},
+			err:          errors.New("conflict"),
+			statusCode:   http.StatusConflict,
+			expectedLog:  "ERROR http_error error=\"conflict\" request_id=req-42 status=409\n",
+			expectedBody: `{"code":409,"id":"req-42"}`,
+			expectedType: "application/json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, tt.opts...)
+			rec := httptest.NewRecorder()
+
+			logger.LogHTTPError(rec, tt.statusCode, tt.err, "req-42", tt.fields)
+
+			if rec.Code != tt.statusCode {
+				t.Errorf("expected status %d; got %d", tt.statusCode, rec.Code)
+			}
+			if rec.Body.String() != tt.expectedBody {
+				t.Errorf("expected body %q; got %q", tt.expectedBody, rec.Body.String())
+			}
+			if rec.Header().Get("Content-Type") != tt.expectedType {
+				t.Errorf("expected content type %q; got %q", tt.expectedType, rec.Header().Get("Content-Type"))
+			}
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expectedLog) {
+				t.Errorf("expected line ending with %q; got %q", tt.expectedLog, content)
+			}
+		})
+	}
+}
+
+func TestHTTPErrorLevelRules(t *testing.T) {
+	captureConsole(t)
+
+	logger := newTestLogger(t)
+	logger.RegisterErrorLevel(IsNotFoundError, LogLevelInfo)
+
+	logger.LogHTTPError(httptest.NewRecorder(), http.StatusNotFound, fs.ErrNotExist, "req-1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/files/a", nil)
+	logger.LogResponseError(httptest.NewRecorder(), req, fs.ErrNotExist, http.StatusNotFound, nil)
+	logger.LogHTTPError(httptest.NewRecorder(), http.StatusInternalServerError, errors.New("boom"), "req-2", nil)
+
+	expected := []string{
+		"INFO http_error error=\"file does not exist\" request_id=req-1 status=404",
+		"INFO response_error error=\"file does not exist\" method=GET path=/files/a status=404",
+		"ERROR http_error error=\"boom\" request_id=req-2 status=500",
+	}
+	content := readLogFile(t, logger)
+	for _, line := range expected {
+		if !strings.Contains(content, line) {
+			t.Errorf("expected %q in log; got %q", line, content)
+		}
+	}
+}
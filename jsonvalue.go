@@ -0,0 +1,20 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LogJSON logs msg with v marshalled as compact JSON in a json field, e.g. `order created json={"id":7}`.
+// If v cannot be marshalled the error is logged in a json_error field instead.
+func (l *FileLogger) LogJSON(level LogLevel, msg string, v interface{}) {
+	l.logAt(level, formatJSONValue(msg, v))
+}
+
+func formatJSONValue(msg string, v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%s json_error=%q", msg, err.Error())
+	}
+	return fmt.Sprintf("%s json=%s", msg, data)
+}
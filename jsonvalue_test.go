@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatJSONValue(t *testing.T) {
+	type order struct {
+		ID    int      `json:"id"`
+		Items []string `json:"items"`
+	}
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "struct", value: order{ID: 7, Items: []string{"book"}}, expected: `order json={"id":7,"items":["book"]}`},
+		{name: "map", value: map[string]int{"b": 2, "a": 1}, expected: `order json={"a":1,"b":2}`},
+		{name: "slice", value: []int{1, 2, 3}, expected: `order json=[1,2,3]`},
+		{name: "nil", value: nil, expected: `order json=null`},
+		{name: "unsupported value", value: make(chan int), expected: `order json_error="json: unsupported type: chan int"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatJSONValue("order", tt.value)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogJSON(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogJSON(LogLevelInfo, "user updated", map[string]interface{}{"id": 42, "active": true})
+
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, `INFO user updated json={"active":true,"id":42}`+"\n") {
+		t.Errorf("expected the JSON value in the log line; got %q", content)
+	}
+}
@@ -0,0 +1,34 @@
+package logger
+
+import "fmt"
+
+// Kubernetes event types accepted by LogKubernetesEvent.
+const (
+	K8sEventNormal  = "Normal"
+	K8sEventWarning = "Warning"
+)
+
+// WithKubernetesMode makes LogKubernetesEvent log Warning events at WARN level; otherwise every event is logged at INFO.
+func WithKubernetesMode(enabled bool) Option {
+	return func(l *FileLogger) {
+		l.KubernetesMode = enabled
+	}
+}
+
+// LogKubernetesEvent logs a Kubernetes event, as `K8S_EVENT [<type>] <reason>: <message> [fields]`.
+// The eventType is expected to be K8sEventNormal or K8sEventWarning.
+func (l *FileLogger) LogKubernetesEvent(reason, message, eventType string, fields map[string]interface{}) {
+	level := LogLevelInfo
+	if l.KubernetesMode && eventType == K8sEventWarning {
+		level = LogLevelWarn
+	}
+	l.logAt(level, formatK8sEvent(reason, message, eventType, fields))
+}
+
+func formatK8sEvent(reason, message, eventType string, fields map[string]interface{}) string {
+	event := fmt.Sprintf("K8S_EVENT [%s] %s: %s", eventType, reason, message)
+	if len(fields) > 0 {
+		event = fmt.Sprintf("%s %s", event, formatFields(fields))
+	}
+	return event
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatK8sEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    string
+		message   string
+		eventType string
+		fields    map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "normal",
+			reason:    "Reconciled",
+			message:   "deployment is up to date",
+			eventType: K8sEventNormal,
+			expected:  "K8S_EVENT [Normal] Reconciled: deployment is up to date",
+		},
+		{
+			name:      "warning with fields",
+			reason:    "BackOff",
+			message:   "restarting failed container",
+			eventType: K8sEventWarning,
+			fields:    map[string]interface{}{"pod": "api-0", "namespace": "prod"},
+			expected:  "K8S_EVENT [Warning] BackOff: restarting failed container namespace=prod pod=api-0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatK8sEvent(tt.reason, tt.message, tt.eventType, tt.fields)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogKubernetesEvent(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		eventType string
+		expected  string
+	}{
+		{name: "normal", eventType: K8sEventNormal, expected: "INFO K8S_EVENT [Normal] Scaled: replicas=3\n"},
+		{name: "warning", eventType: K8sEventWarning, expected: "INFO K8S_EVENT [Warning] Scaled: replicas=3\n"},
+		{name: "kubernetes mode normal", opts: []Option{WithKubernetesMode(true)}, eventType: K8sEventNormal, expected: "INFO K8S_EVENT [Normal] Scaled: replicas=3\n"},
+		{name: "kubernetes mode warning", opts: []Option{WithKubernetesMode(true)}, eventType: K8sEventWarning, expected: "WARNING K8S_EVENT [Warning] Scaled: replicas=3\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, tt.opts...)
+			logger.LogKubernetesEvent("Scaled", "replicas=3", tt.eventType, nil)
+
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected line ending with %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+)
+
+var _ Logger = (*LazyLogger)(nil)
+
+// LazyLogger is a Logger that creates its FileLogger, and with it the log file, on the first write.
+// This keeps runs that never log, e.g. with --help or --version, from creating log files.
+type LazyLogger struct {
+	// InitErr holds the error that prevented the FileLogger from being created, if any.
+	// Once it is set every write is dropped, except for fatal errors which are still printed before exiting.
+	InitErr error
+
+	devMode bool
+	logDir  func() (string, error)
+	opts    []Option
+	once    sync.Once
+	logger  *FileLogger
+}
+
+// NewLazyLogger returns a LazyLogger that creates its FileLogger with NewLogger's parameters on the first write.
+// Unlike NewLogger, a failure to create the logger does not terminate the program but is stored in InitErr.
+func NewLazyLogger(devMode bool, appDir string, opts ...Option) *LazyLogger {
+	return newLazyLogger(devMode, func() (string, error) { return userLogDir(appDir) }, opts...)
+}
+
+func newLazyLogger(devMode bool, logDir func() (string, error), opts ...Option) *LazyLogger {
+	return &LazyLogger{devMode: devMode, logDir: logDir, opts: opts}
+}
+
+// get returns the FileLogger, creating it on the first call, or nil if it could not be created.
+func (z *LazyLogger) get() *FileLogger {
+	z.once.Do(func() {
+		logDir, err := z.logDir()
+		if err != nil {
+			z.InitErr = err
+			return
+		}
+		z.logger, z.InitErr = newLogger(z.devMode, logDir, z.opts...)
+	})
+	return z.logger
+}
+
+// Close closes the FileLogger if it was created. Writes after Close are dropped and never create it.
+func (z *LazyLogger) Close() error {
+	z.once.Do(func() {})
+	if z.logger == nil {
+		return nil
+	}
+	return z.logger.Close()
+}
+
+func (z *LazyLogger) LogFatal(err error) {
+	if l := z.get(); l != nil {
+		l.LogFatal(err)
+		return
+	}
+	console().Fatal(LogLevelFatal.String() + " " + err.Error())
+}
+
+func (z *LazyLogger) LogError(err error) {
+	if l := z.get(); l != nil {
+		l.LogError(err)
+	}
+}
+
+func (z *LazyLogger) LogWarn(message string) {
+	if l := z.get(); l != nil {
+		l.LogWarn(message)
+	}
+}
+
+func (z *LazyLogger) LogInfo(message string) {
+	if l := z.get(); l != nil {
+		l.LogInfo(message)
+	}
+}
+
+func (z *LazyLogger) LogDebug(message string) {
+	if l := z.get(); l != nil {
+		l.LogDebug(message)
+	}
+}
+
+func (z *LazyLogger) LogTrace(message string) {
+	if l := z.get(); l != nil {
+		l.LogTrace(message)
+	}
+}
+
+func (z *LazyLogger) LogBytes(level LogLevel, label string, data []byte) {
+	if l := z.get(); l != nil {
+		l.LogBytes(level, label, data)
+	}
+}
+
+func (z *LazyLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	if l := z.get(); l != nil {
+		l.LogChange(field, from, to, extra)
+	}
+}
+
+func (z *LazyLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	if l := z.get(); l != nil {
+		l.LogDiff(level, label, before, after)
+	}
+}
+
+func (z *LazyLogger) LogFatalf(format string, args ...interface{}) {
+	z.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (z *LazyLogger) LogErrorf(format string, args ...interface{}) {
+	z.LogError(fmt.Errorf(format, args...))
+}
+
+func (z *LazyLogger) LogWarnf(format string, args ...interface{}) {
+	z.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (z *LazyLogger) LogInfof(format string, args ...interface{}) {
+	z.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (z *LazyLogger) LogDebugf(format string, args ...interface{}) {
+	z.LogDebug(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLazyLogger(t *testing.T) {
+	captureConsole(t)
+	logDir := filepath.Join(t.TempDir(), "logs")
+	lazy := newLazyLogger(false, func() (string, error) { return logDir, nil })
+	defer lazy.Close()
+
+	if _, err := os.Stat(logDir); !os.IsNotExist(err) {
+		t.Fatalf("expected no log directory before the first write; got %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lazy.LogInfo("first write")
+		}()
+	}
+	wg.Wait()
+
+	if lazy.InitErr != nil {
+		t.Fatalf("expected no init error; got %s", lazy.InitErr)
+	}
+	if entries := readLogDir(t, logDir); len(entries) != 1 {
+		t.Fatalf("expected a single log file after the first write; got %v", entries)
+	}
+	if content := readLogFile(t, lazy.logger); strings.Count(content, "INFO first write") != 10 {
+		t.Errorf("expected 10 entries; got %q", content)
+	}
+}
+
+func TestLazyLoggerInitErr(t *testing.T) {
+	captureConsole(t)
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0666); err != nil {
+		t.Fatalf("failed to create file: %s", err)
+	}
+	lazy := newLazyLogger(false, func() (string, error) { return filepath.Join(blocker, "logs"), nil })
+
+	lazy.LogInfo("first write")
+	initErr := lazy.InitErr
+	if initErr == nil {
+		t.Fatalf("expected an init error")
+	}
+
+	lazy.LogWarn("second write")
+	if lazy.InitErr != initErr {
+		t.Errorf("expected the init error to be preserved; got %v", lazy.InitErr)
+	}
+	if err := lazy.Close(); err != nil {
+		t.Errorf("expected closing a failed logger to succeed; got %s", err)
+	}
+}
+
+func TestLazyLoggerClose(t *testing.T) {
+	logDir := filepath.Join(t.TempDir(), "logs")
+	lazy := newLazyLogger(false, func() (string, error) { return logDir, nil })
+
+	if err := lazy.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+	lazy.LogWarn("after close")
+
+	if _, err := os.Stat(logDir); !os.IsNotExist(err) {
+		t.Errorf("expected no log directory after closing an unused logger; got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LogLevel is the severity of a log message.
+type LogLevel int
+
+const (
+	LogLevelTrace LogLevel = iota - 1
+	LogLevelDebug
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+	LogLevelFatal
+	LogLevelEmergency
+)
+
+// String returns the token written in front of messages of the level.
+func (level LogLevel) String() string {
+	switch level {
+	case LogLevelTrace:
+		return "TRACE"
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARNING"
+	case LogLevelError:
+		return "ERROR"
+	case LogLevelFatal:
+		return "FATAL"
+	case LogLevelEmergency:
+		return "EMERGENCY"
+	}
+	return "UNKNOWN"
+}
+
+// MarshalText returns the level name, so that levels are written as text in config files.
+func (level LogLevel) MarshalText() ([]byte, error) {
+	return []byte(level.String()), nil
+}
+
+// UnmarshalText parses a level name such as "debug" or "WARNING", ignoring case.
+// "warn" is accepted as an alias for the warning level.
+func (level *LogLevel) UnmarshalText(text []byte) error {
+	name := strings.ToUpper(strings.TrimSpace(string(text)))
+	if name == "WARN" {
+		name = "WARNING"
+	}
+
+	for l := LogLevelTrace; l <= LogLevelEmergency; l++ {
+		if l.String() == name {
+			*level = l
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown log level %q", text)
+}
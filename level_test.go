@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogLevelUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected LogLevel
+		wantErr  bool
+	}{
+		{text: "trace", expected: LogLevelTrace},
+		{text: "DEBUG", expected: LogLevelDebug},
+		{text: "Info", expected: LogLevelInfo},
+		{text: "warn", expected: LogLevelWarn},
+		{text: "warning", expected: LogLevelWarn},
+		{text: " error ", expected: LogLevelError},
+		{text: "fatal", expected: LogLevelFatal},
+		{text: "Emergency", expected: LogLevelEmergency},
+		{text: "verbose", wantErr: true},
+		{text: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var level LogLevel
+			err := level.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %t; got %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && level != tt.expected {
+				t.Errorf("expected %s; got %s", tt.expected, level)
+			}
+		})
+	}
+}
+
+func TestLogLevelString(t *testing.T) {
+	if LogLevelTrace >= LogLevelDebug {
+		t.Errorf("expected trace to be below debug")
+	}
+	for level := LogLevelTrace; level <= LogLevelEmergency; level++ {
+		var parsed LogLevel
+		if err := parsed.UnmarshalText([]byte(level.String())); err != nil || parsed != level {
+			t.Errorf("expected %s to round trip; got %s, %v", level, parsed, err)
+		}
+	}
+}
+
+func TestLogTrace(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name     string
+		devMode  bool
+		minLevel LogLevel
+		written  bool
+	}{
+		{name: "dev mode with trace level", devMode: true, minLevel: LogLevelTrace, written: true},
+		{name: "dev mode with debug level", devMode: true, minLevel: LogLevelDebug},
+		{name: "production with trace level", devMode: false, minLevel: LogLevelTrace},
+		{name: "production with debug level", devMode: false, minLevel: LogLevelDebug},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, WithMinLevel(tt.minLevel))
+			logger.SetDevMode(tt.devMode)
+
+			logger.LogTrace("read byte")
+			logger.LogTraceWith("read byte", map[string]interface{}{"value": "0x1f"})
+
+			content := readLogFile(t, logger)
+			written := strings.Contains(content, "TRACE read byte\n") && strings.Contains(content, "TRACE read byte value=0x1f\n")
+			if written != tt.written {
+				t.Errorf("expected trace written %t; got %q", tt.written, content)
+			}
+		})
+	}
+}
+
+func TestMinLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelWarn))
+
+	logger.LogDebug("first debug")
+	logger.LogInfo("first info")
+	logger.LogWarn("first warning")
+
+	logger.SetMinLevel(LogLevelInfo)
+	logger.LogDebug("second debug")
+	logger.LogInfo("second info")
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	expected := []string{"WARNING first warning", "INFO second info"}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines; got %q", len(expected), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("expected line %d to end with %q; got %q", i, expected[i], line)
+		}
+	}
+	if logger.MinLevel() != LogLevelInfo {
+		t.Errorf("expected min level %s; got %s", LogLevelInfo, logger.MinLevel())
+	}
+}
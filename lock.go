@@ -0,0 +1,27 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// lockFileName is the file in the log directory that is locked while a log file is chosen and created.
+const lockFileName = ".lock"
+
+// lockLogDir takes an exclusive lock on the log directory, blocking until other processes and loggers release it,
+// and returns the function that releases it.
+func lockLogDir(logDir string) (func(), error) {
+	f, err := os.OpenFile(filepath.Join(logDir, lockFileName), os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return func() {
+		unlockFile(f)
+		f.Close()
+	}, nil
+}
@@ -0,0 +1,14 @@
+//go:build !unix && !windows
+
+package logger
+
+import "os"
+
+// File locking is not available on this platform, so log file creation is not guarded against other processes.
+func lockFile(f *os.File) error {
+	return nil
+}
+
+func unlockFile(f *os.File) error {
+	return nil
+}
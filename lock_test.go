@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// openConcurrently calls open from n goroutines at once and returns the names of the opened files.
+func openConcurrently(t *testing.T, n int, open func() (*os.File, error)) []string {
+	t.Helper()
+
+	names := make([]string, n)
+	errs := make([]error, n)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			f, err := open()
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			names[i] = filepath.Base(f.Name())
+			f.Close()
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("failed to open log file: %s", err)
+		}
+	}
+	return names
+}
+
+func TestGetUserLogFileConcurrent(t *testing.T) {
+	logDir := t.TempDir()
+	y, m, d := time.Now().Date()
+	expected := fmt.Sprintf("%d-%d-%d_1.log", y, m, d)
+
+	names := openConcurrently(t, 10, func() (*os.File, error) {
+		return getUserLogFile(logDir, 0, RotateDaily, 0)
+	})
+	for _, name := range names {
+		if name != expected {
+			t.Errorf("expected %s; got %s", expected, name)
+		}
+	}
+
+	if entries := readLogDir(t, logDir); len(entries) != 1 || entries[0].Name() != expected {
+		t.Errorf("expected only %s to be created; got %v", expected, entries)
+	}
+}
+
+func TestGetNextLogFileConcurrent(t *testing.T) {
+	logDir := t.TempDir()
+
+	names := openConcurrently(t, 10, func() (*os.File, error) {
+		return getNextLogFile(logDir, 0, RotateDaily, 0)
+	})
+
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if seen[name] {
+			t.Errorf("expected every logger to get its own file; %s was opened twice", name)
+		}
+		seen[name] = true
+	}
+}
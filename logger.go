@@ -1,14 +1,21 @@
 package logger
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"os/user"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"golang.org/x/term"
 )
 
 type Logger interface {
@@ -17,13 +24,224 @@ type Logger interface {
 	LogWarn(message string)
 	LogInfo(message string)
 	LogDebug(message string)
+	LogBytes(level LogLevel, label string, data []byte)
+	LogChange(field string, from, to interface{}, extra map[string]interface{})
+	LogDiff(level LogLevel, label string, before, after interface{})
+	LogTrace(message string)
+	LogFatalf(format string, args ...interface{})
+	LogErrorf(format string, args ...interface{})
+	LogWarnf(format string, args ...interface{})
+	LogInfof(format string, args ...interface{})
+	LogDebugf(format string, args ...interface{})
 }
 
 type FileLogger struct {
-	DevMode        bool
-	LogDir         string
-	CurrentLogFile *os.File
-	FileLog        *log.Logger
+	DevMode                    bool
+	LogDir                     string
+	CurrentLogFile             *os.File
+	FileLog                    *log.Logger
+	EncryptionKey              []byte
+	ColorScheme                ColorScheme
+	OnAfterRotate              func(oldPath, newPath string)
+	RedactConfigKeys           []string
+	WatchdogInterval           time.Duration
+	WatchdogFn                 func(silence time.Duration)
+	MaxBytesLogged             int
+	ChangeMinLevel             LogLevel
+	SeqPadding                 int
+	Rotation                   RotationPolicy
+	IncludePIDInFilename       bool
+	KubernetesMode             bool
+	MaxLinesPerFile            int64
+	MaxFileSizeBytes           int64
+	ErrorResponseTemplate      func(err error, code int) []byte
+	HTTPErrorTemplate          func(statusCode int, err error, requestID string) ([]byte, string)
+	WriteChecksum              bool
+	ChecksumAlgorithm          string
+	TextEncoding               string
+	DeduplicateAcrossProcesses bool
+	BloomFalsePositiveRate     float64
+	EmergencyFn                func(message string, fields map[string]interface{})
+	MaxMultilineLines          int
+	IncludeHostname            bool
+	CacheStatsMinLevel         LogLevel
+	SlowQueryThreshold         time.Duration
+	LogQueryParams             bool
+	MaxQueryParamLen           int
+	NetworkLogMinLevel         LogLevel
+	RateLimitMinLevel          LogLevel
+	ConnectionLogMinLevel      LogLevel
+	MemStatsKeys               []string
+	FileOpThresholdMs          int64
+	ConfigChangeHook           func(key string, oldVal, newVal interface{})
+	SlowOperationHook          func(name string, actual time.Duration)
+	MaxHTTPBodyLogBytes        int
+	CompressRotated            bool
+	CompressAfter              time.Duration
+	SecurityAuditFile          string
+
+	mu                 sync.Mutex
+	rotationStopped    bool
+	externalFile       bool
+	compressing        bool
+	compressEvents     chan CompressEvent
+	compressQueueMu    sync.Mutex
+	compressQueue      []pendingCompression
+	compressWake       chan struct{}
+	closed             bool
+	done               chan struct{}
+	envWatchStop       chan struct{}
+	goroutineCountStop chan struct{}
+	lastGoroutines     atomic.Int64
+	wg                 sync.WaitGroup
+	lastWrite          atomic.Int64
+	minLevel           atomic.Int64
+	devMode            atomic.Bool
+	prodMode           modeSnapshot
+	prefix             string
+	hostname           string
+	pid                int
+	ring               *ringBuffer
+	dirLock            *dirLock
+	outputsMu          sync.RWMutex
+	outputs            []*namedOutput
+	dbOutputs          []*dbOutput
+	dbDropped          atomic.Uint64
+	throttled          atomic.Uint64
+	linesWritten       atomic.Int64
+	duplicates         atomic.Uint64
+	progressStarts     sync.Map // map[string]time.Time
+	extractorsMu       sync.RWMutex
+	extractors         []func(context.Context) map[string]interface{}
+	errorLevelsMu      sync.RWMutex
+	errorLevels        []errorLevelRule
+	once               sync.Map // map[uint64]struct{} of the writes seen by OnceLogger
+	suppressMu         sync.RWMutex
+	suppressRules      []suppressRule
+	nextSuppressID     int
+	suppressed         atomic.Uint64
+	securityFile       *os.File
+	securityLog        *log.Logger
+	tagsMu             sync.RWMutex
+	tags               []string
+}
+
+// ColorScheme holds the ANSI escape codes used to color the level token of console output in DevMode.
+// An empty code leaves that level uncolored. Emergency messages use the Fatal color.
+type ColorScheme struct {
+	Debug string
+	Info  string
+	Warn  string
+	Error string
+	Fatal string
+}
+
+const colorReset = "\033[0m"
+
+// isTerminal reports whether w is attached to a terminal; console colors are disabled otherwise.
+var isTerminal = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+func (cs ColorScheme) color(level LogLevel) string {
+	switch level {
+	case LogLevelDebug:
+		return cs.Debug
+	case LogLevelInfo:
+		return cs.Info
+	case LogLevelWarn:
+		return cs.Warn
+	case LogLevelError:
+		return cs.Error
+	case LogLevelFatal, LogLevelEmergency:
+		return cs.Fatal
+	}
+	return ""
+}
+
+// DefaultColorScheme returns the colors used in DevMode unless WithColorScheme is given:
+// cyan for debug, green for info, yellow for warnings, red for errors and bold red for fatal messages.
+func DefaultColorScheme() ColorScheme {
+	return ColorScheme{
+		Debug: "\033[36m",
+		Info:  "\033[32m",
+		Warn:  "\033[33m",
+		Error: "\033[31m",
+		Fatal: "\033[1;31m",
+	}
+}
+
+// Option configures optional FileLogger behaviour when passed to NewLogger.
+type Option func(*FileLogger)
+
+// WithColorScheme sets the colors of the level token in DevMode console output.
+func WithColorScheme(cs ColorScheme) Option {
+	return func(l *FileLogger) {
+		l.ColorScheme = cs
+	}
+}
+
+// WithOnAfterRotate registers fn to be called with the old and new paths every time the logger switches to another file.
+func WithOnAfterRotate(fn func(oldPath, newPath string)) Option {
+	return func(l *FileLogger) {
+		l.OnAfterRotate = fn
+	}
+}
+
+// WithDevMode enables or disables development mode, overriding the devMode argument of the constructor.
+func WithDevMode(devMode bool) Option {
+	return func(l *FileLogger) {
+		l.DevMode = devMode
+	}
+}
+
+// WithMinLevel discards messages below level; the default is LogLevelDebug.
+func WithMinLevel(level LogLevel) Option {
+	return func(l *FileLogger) {
+		l.SetMinLevel(level)
+	}
+}
+
+// WithPrefix writes prefix between the timestamp and the level of every log file line, e.g. to tell tenants apart.
+func WithPrefix(prefix string) Option {
+	return func(l *FileLogger) {
+		l.prefix = prefix
+	}
+}
+
+// WithSeqPadding zero-pads the sequence number of log file names to n digits, e.g. 2025-1-2_001.log for n = 3,
+// so that the files sort chronologically by name. Files named with a different padding are ignored.
+func WithSeqPadding(n int) Option {
+	return func(l *FileLogger) {
+		l.SeqPadding = n
+	}
+}
+
+const defaultMaxFileSizeBytes = 10000000
+
+// WithMaxFileSizeBytes rotates the log file once it reaches n bytes, 10 MB by default. Zero or a negative n disables
+// size based rotation.
+func WithMaxFileSizeBytes(n int64) Option {
+	return func(l *FileLogger) {
+		l.MaxFileSizeBytes = n
+	}
+}
+
+// WithMaxLinesPerFile rotates the log file once n lines have been written to it, in addition to the size limit.
+// Lines written to a file before the logger opened it are not counted, and the entries of a LogGroup are never split.
+func WithMaxLinesPerFile(n int64) Option {
+	return func(l *FileLogger) {
+		l.MaxLinesPerFile = n
+	}
+}
+
+// WithEncryptionKey encrypts everything written to the log files with AES-GCM.
+// The key must be 16, 24 or 32 bytes long; use NewDecryptingReader to read the files back.
+func WithEncryptionKey(key []byte) Option {
+	return func(l *FileLogger) {
+		l.EncryptionKey = key
+	}
 }
 
 // NewLogger creates a new FileLogger instance.
@@ -31,152 +249,579 @@ type FileLogger struct {
 // Parameters:
 //   - devMode: a boolean indicating whether the logger should output more detailed messages suitable for debugging.
 //   - appDir: a string representing the subdirectory where log files should be stored. This should be a relative path, and will result in `user_home_dir/[appDir]/logs`.
-func NewLogger(devMode bool, appDir string) *FileLogger {
-	if devMode {
+//   - opts: optional settings such as WithEncryptionKey or WithColorScheme.
+func NewLogger(devMode bool, appDir string, opts ...Option) *FileLogger {
+	l, err := NewLoggerWithOptions(appDir, append([]Option{WithDevMode(devMode)}, opts...)...)
+	if err != nil {
+		message := fmt.Sprintf("FATAL %s", err.Error())
+		log.Fatal(message)
+	}
+
+	return l
+}
+
+// NewLoggerWithOptions creates a FileLogger writing to `user_home_dir/[appDir]/logs` like NewLogger, with every
+// setting, including the mode, given as an option such as WithDevMode, WithMinLevel or WithMaxFileSizeBytes.
+// Errors are returned instead of terminating the program.
+func NewLoggerWithOptions(appDir string, opts ...Option) (*FileLogger, error) {
+	logDir, err := userLogDir(appDir)
+	if err != nil {
+		return nil, err
+	}
+	return NewLoggerWithPath(false, logDir, LogLevelDebug, opts...)
+}
+
+// NewLoggerWithPath creates a FileLogger like NewLogger that writes to logDir, an absolute path such as /var/log/myapp
+// or a mounted volume, instead of a directory under the user's home. The directory is created if needed, and errors
+// are returned instead of terminating the program. minLevel is applied before opts.
+func NewLoggerWithPath(devMode bool, logDir string, minLevel LogLevel, opts ...Option) (*FileLogger, error) {
+	if !filepath.IsAbs(logDir) {
+		return nil, fmt.Errorf("log directory %q is not an absolute path", logDir)
+	}
+
+	l, err := newLogger(devMode, logDir, append([]Option{WithMinLevel(minLevel)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	if l.IsDevMode() {
 		log.Println("INFO logger running in development mode")
 	}
+	return l, nil
+}
 
+// userLogDir returns the log directory for appDir, `user_home_dir/[appDir]/logs`.
+func userLogDir(appDir string) (string, error) {
 	currentUser, err := user.Current()
 	if err != nil {
-		message := fmt.Sprintf("FATAL failed getting the current os user: %s", err.Error())
-		log.Fatal(message)
+		return "", fmt.Errorf("failed getting the current os user: %w", err)
 	}
 
-	homeDir := currentUser.HomeDir
-	logDir := filepath.Join(homeDir, appDir, "logs")
-	if err = os.MkdirAll(logDir, 0755); err != nil {
-		message := fmt.Sprintf("FATAL failed creating log directory: %s", err.Error())
-		log.Fatal(message)
+	return filepath.Join(currentUser.HomeDir, appDir, "logs"), nil
+}
+
+// newLogger creates a FileLogger writing to logDir, creating the directory if needed.
+func newLogger(devMode bool, logDir string, opts ...Option) (*FileLogger, error) {
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed creating log directory: %w", err)
+	}
+
+	l := &FileLogger{
+		DevMode:          devMode,
+		LogDir:           logDir,
+		ColorScheme:      DefaultColorScheme(),
+		ChangeMinLevel:   LogLevelInfo,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+	for _, opt := range opts {
+		opt(l)
 	}
 
-	var fileLogger *log.Logger
-	logFile, err := getUserLogFile(logDir)
+	err := l.start(func() (*os.File, error) {
+		return getUserLogFile(logDir, l.SeqPadding, l.Rotation, l.filenamePID())
+	})
 	if err != nil {
-		message := fmt.Sprintf("FATAL failed getting log file: %s", err.Error())
-		log.Fatal(message)
-	} else {
-		fileLogger = log.New(logFile, "", log.LstdFlags)
+		return nil, err
+	}
+	return l, nil
+}
+
+// start sets up a logger whose options have been applied, writing to the log file returned by openFile,
+// and starts its background goroutines.
+func (l *FileLogger) start(openFile func() (*os.File, error)) error {
+	logDir := l.LogDir
+	l.devMode.Store(l.DevMode)
+	l.saveProductionMode()
+	hostnameErr := l.resolveHostname()
+	l.pid = getpid()
+	l.dirLock = registerLogDir(logDir)
+
+	logFile, err := openFile()
+	if err != nil {
+		UnregisterLogDir(logDir)
+		return fmt.Errorf("failed getting log file: %w", err)
+	}
+
+	if err = l.setLogFile(logFile); err != nil {
+		logFile.Close()
+		UnregisterLogDir(logDir)
+		return fmt.Errorf("failed setting up log file: %w", err)
+	}
+
+	if l.WatchdogInterval > 0 && l.WatchdogFn != nil {
+		l.startWatchdog()
+	}
+	if l.CompressRotated {
+		l.startCompressWorker()
 	}
 
-	return &FileLogger{DevMode: devMode, LogDir: logDir, CurrentLogFile: logFile, FileLog: fileLogger}
+	if hostnameErr != nil {
+		l.LogWarn(fmt.Sprintf("failed getting hostname: %s", hostnameErr.Error()))
+	}
+	return nil
+}
+
+// Close stops the background goroutines of the logger and closes the current log file,
+// unless it was provided through SetPrimaryOutput. Messages logged after Close are only written to the console.
+func (l *FileLogger) Close() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.closed = true
+	if l.done != nil {
+		close(l.done)
+	}
+	l.mu.Unlock()
+
+	l.wg.Wait()
+	releaseThrottleState(l)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.dirLock != nil {
+		UnregisterLogDir(l.LogDir)
+	}
+	return errors.Join(l.closeCurrentFile(), l.closeSecurityAudit())
+}
+
+// goBackground runs fn in a goroutine that is stopped by Close through the done channel.
+func (l *FileLogger) goBackground(fn func(done <-chan struct{})) {
+	l.mu.Lock()
+	if l.done == nil {
+		l.done = make(chan struct{})
+	}
+	done := l.done
+	l.wg.Add(1)
+	l.mu.Unlock()
+
+	go func() {
+		defer l.wg.Done()
+		fn(done)
+	}()
+}
+
+// LastWriteTime returns the time of the last write to the log file, or the zero time if nothing was written yet.
+func (l *FileLogger) LastWriteTime() time.Time {
+	nanos := l.lastWrite.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
 }
 
 func (l *FileLogger) LogFatal(err error) {
-	message := fmt.Sprintf("FATAL %s", err.Error())
-	l.logToFile(message)
-	log.Fatal(message)
+	l.logAt(LogLevelFatal, err.Error())
 }
 
+// LogError logs err at ERROR level, or at the level of the first rule registered with RegisterErrorLevel that matches it.
 func (l *FileLogger) LogError(err error) {
-	message := fmt.Sprintf("ERROR %s", err.Error())
-	log.Println(message)
-	l.logToFile(message)
+	l.logAt(l.errorLevel(err), err.Error())
 }
 
 func (l *FileLogger) LogWarn(message string) {
-	message = fmt.Sprintf("WARNING %s", message)
-	log.Println(message)
-	l.logToFile(message)
+	l.logAt(LogLevelWarn, message)
 }
 
 func (l *FileLogger) LogInfo(message string) {
-	message = fmt.Sprintf("INFO %s", message)
-	log.Println(message)
-	l.logToFile(message)
+	l.logAt(LogLevelInfo, message)
 }
 
 func (l *FileLogger) LogDebug(message string) {
-	message = fmt.Sprintf("DEBUG %s", message)
-	l.logToFile(message)
+	l.logAt(LogLevelDebug, message)
+}
+
+// LogTrace logs ultra-verbose messages, which are only written in DevMode with the minimum level set to LogLevelTrace.
+func (l *FileLogger) LogTrace(message string) {
+	l.logAt(LogLevelTrace, message)
+}
+
+// LogTraceWith logs a trace message like LogTrace and appends fields, e.g. built with NewFields, as key=value pairs.
+func (l *FileLogger) LogTraceWith(message string, fields map[string]interface{}) {
+	if len(fields) > 0 {
+		message = fmt.Sprintf("%s %s", message, formatFields(fields))
+	}
+	l.logAt(LogLevelTrace, message)
+}
+
+// LogFatalf logs fmt.Errorf(format, args...) like LogFatal, so that %w wraps an error into the message.
+func (l *FileLogger) LogFatalf(format string, args ...interface{}) {
+	l.LogFatal(fmt.Errorf(format, args...))
+}
+
+// LogErrorf logs fmt.Errorf(format, args...) like LogError, so that %w wraps an error and the error level rules
+// see it.
+func (l *FileLogger) LogErrorf(format string, args ...interface{}) {
+	l.LogError(fmt.Errorf(format, args...))
+}
+
+// LogWarnf logs fmt.Sprintf(format, args...) like LogWarn. The message is only formatted if the level is enabled.
+func (l *FileLogger) LogWarnf(format string, args ...interface{}) {
+	if l.enabled(LogLevelWarn) {
+		l.LogWarn(fmt.Sprintf(format, args...))
+	}
+}
+
+// LogInfof logs fmt.Sprintf(format, args...) like LogInfo. The message is only formatted if the level is enabled.
+func (l *FileLogger) LogInfof(format string, args ...interface{}) {
+	if l.enabled(LogLevelInfo) {
+		l.LogInfo(fmt.Sprintf(format, args...))
+	}
+}
+
+// LogDebugf logs fmt.Sprintf(format, args...) like LogDebug. The message is only formatted if the level is enabled.
+func (l *FileLogger) LogDebugf(format string, args ...interface{}) {
+	if l.enabled(LogLevelDebug) {
+		l.LogDebug(fmt.Sprintf(format, args...))
+	}
+}
+
+// MinLevel returns the lowest level that is logged.
+func (l *FileLogger) MinLevel() LogLevel {
+	return LogLevel(l.minLevel.Load())
+}
+
+// SetMinLevel changes the lowest level that is logged; it is safe to call while logging.
+func (l *FileLogger) SetMinLevel(level LogLevel) {
+	l.minLevel.Store(int64(level))
+}
+
+// Prefix returns the prefix written between the timestamp and the level of every log file line.
+func (l *FileLogger) Prefix() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.prefix
+}
+
+// SetPrefix changes the prefix of the log file lines; lines written before the call keep the previous prefix.
+func (l *FileLogger) SetPrefix(prefix string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prefix = prefix
+	if l.FileLog != nil {
+		l.FileLog.SetPrefix(l.linePrefix())
+	}
+}
+
+// IsDevMode reports whether the logger is in development mode.
+func (l *FileLogger) IsDevMode() bool {
+	return l.devMode.Load()
+}
+
+// SetDevMode switches development mode on or off; it is safe to call while logging.
+// The DevMode field only sets the initial mode, so it must not be changed once the logger is created.
+func (l *FileLogger) SetDevMode(dev bool) {
+	l.devMode.Store(dev)
+}
+
+// enabled reports whether messages at level are logged.
+// Trace messages additionally require DevMode so that they never reach production logs by accident,
+// while fatal and emergency messages are always logged.
+func (l *FileLogger) enabled(level LogLevel) bool {
+	if level == LogLevelTrace && !l.devMode.Load() {
+		return false
+	}
+	return level >= l.MinLevel() || level >= LogLevelFatal
+}
+
+// logAt writes message at the given level to the log file and, except for debug and trace messages outside DevMode, to the console.
+// Fatal messages terminate the program after being written.
+func (l *FileLogger) logAt(level LogLevel, message string) {
+	l.logTaggedAt(level, message, l.Tags())
+}
+
+// logTaggedAt is logAt with the tags to append to message instead of those of the logger.
+func (l *FileLogger) logTaggedAt(level LogLevel, message string, tags []string) {
+	if !l.enabled(level) || l.suppress(level, message) {
+		return
+	}
+	l.writeTagged(level, message, tags)
+}
+
+// writeAt writes message at level to all destinations without checking the minimum level.
+func (l *FileLogger) writeAt(level LogLevel, message string) {
+	l.writeTagged(level, message, l.Tags())
+}
+
+func (l *FileLogger) writeTagged(level LogLevel, message string, tags []string) {
+	l.logTaggedToFile(tags, fmt.Sprintf("%s %s", level, message))
+	message = formatTags(message, tags)
+	l.logToOutputs(level, fmt.Sprintf("%s %s", level, message))
+	l.logToConsole(level, message)
+}
+
+func (l *FileLogger) logToConsole(level LogLevel, message string) {
+	if level == LogLevelFatal {
+		console().Fatal(l.consoleMessage(level, message))
+	}
+	if level > LogLevelDebug || l.devMode.Load() {
+		console().Println(l.consoleMessage(level, message))
+	}
+}
+
+// consoleMessage builds a console line, coloring only the level token when running in DevMode on a terminal.
+func (l *FileLogger) consoleMessage(level LogLevel, message string) string {
+	token := level.String()
+	if color := l.ColorScheme.color(level); l.devMode.Load() && color != "" && isTerminal(console().Writer()) {
+		token = color + token + colorReset
+	}
+	return fmt.Sprintf("%s %s", token, message)
+}
+
+// ReplaceFile switches the logger to newPath without losing writes, e.g. after an operator moved the log to a new volume.
+// The current file is synced and closed only once newPath is open, so on error the logger keeps writing to it.
+// Date and size based rotation is stopped afterwards, since the file is now managed by the caller.
+func (l *FileLogger) ReplaceFile(newPath string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	logFile, err := os.OpenFile(newPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+
+	fileLog, err := l.newFileLog(logFile)
+	if err != nil {
+		logFile.Close()
+		return err
+	}
+
+	var oldPath string
+	if l.CurrentLogFile != nil {
+		oldPath = l.CurrentLogFile.Name()
+	}
+	l.closeCurrentFile()
 
-	if l.DevMode {
-		log.Println(message)
+	l.CurrentLogFile = logFile
+	l.FileLog = fileLog
+	l.externalFile = false
+	l.rotationStopped = true
+
+	if l.OnAfterRotate != nil {
+		l.OnAfterRotate(oldPath, logFile.Name())
 	}
+	return nil
 }
 
-func (l *FileLogger) logToFile(message string) {
+// SetPrimaryOutput redirects the log file output to w, e.g. from a temporary file used during setup to the real one.
+// Lines written before the call go to the previous output and lines written after it go to w.
+// The previous file is synced and, unless it was itself passed to SetPrimaryOutput, closed.
+// Rotation is stopped because w is managed by the caller; passing the current file again is a no-op.
+func (l *FileLogger) SetPrimaryOutput(w io.Writer) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, _ := w.(*os.File)
+	if file != nil && file == l.CurrentLogFile {
+		return nil
+	}
+
+	fileLog, err := l.newFileLog(w)
+	if err != nil {
+		return err
+	}
+	l.closeCurrentFile()
+
+	l.CurrentLogFile = file
+	l.FileLog = fileLog
+	l.externalFile = true
+	l.rotationStopped = true
+	return nil
+}
+
+// logToFile writes the messages to the current log file as consecutive lines, each followed by the tags of the
+// logger, refreshing the file first if needed. Loggers of the process sharing the log directory take turns, so that
+// they never rotate or write at the same time.
+func (l *FileLogger) logToFile(messages ...string) {
+	l.logTaggedToFile(l.Tags(), messages...)
+}
+
+// logTaggedToFile is logToFile with the tags to append to the messages instead of those of the logger.
+func (l *FileLogger) logTaggedToFile(tags []string, messages ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return
+	}
+	if l.dirLock != nil {
+		l.dirLock.mu.Lock()
+		defer l.dirLock.mu.Unlock()
+	}
+
 	err := l.refreshLogFile()
 	if err != nil {
 		message := fmt.Sprintf("FATAL failed refreshing log file: %s", err.Error())
-		log.Fatal(message)
+		console().Fatal(message)
 	}
 
-	l.FileLog.Println(message)
+	for _, message := range messages {
+		l.FileLog.Println(formatTags(message, tags))
+	}
+	l.linesWritten.Add(int64(len(messages)))
+	l.lastWrite.Store(time.Now().UnixNano())
 }
 
 func (l *FileLogger) refreshLogFile() error {
+	if l.rotationStopped {
+		return nil
+	}
+
 	filename := filepath.Base(l.CurrentLogFile.Name())
 
-	now := time.Now()
-	y, m, d := now.Date()
-	date := fmt.Sprintf(`%d-%d-%d`, y, m, d)
+	date := logFilePrefix(time.Now(), l.Rotation, l.filenamePID())
 
-	var newFileName string
-	if !strings.HasPrefix(filename, date) {
-		newFileName = fmt.Sprintf(`%s_1.log`, date)
-	} else {
+	num, ok := parseLogFileSeq(filename, date, l.SeqPadding)
+	if ok {
 		info, err := l.CurrentLogFile.Stat()
 		if err != nil {
 			return err
 		}
 
-		if info.Size() < 10000000 {
+		sizeReached := l.MaxFileSizeBytes > 0 && info.Size() >= l.MaxFileSizeBytes
+		linesReached := l.MaxLinesPerFile > 0 && l.linesWritten.Load() >= l.MaxLinesPerFile
+		if !sizeReached && !linesReached {
 			return nil
 		}
+	}
+
+	// The next file is chosen and created with the log directory locked, like in openLogFile. If another process
+	// sharing the directory already rotated past the current file, its file is joined instead of skipped.
+	unlock, err := lockLogDir(l.LogDir)
+	if err != nil {
+		return fmt.Errorf("failed locking log directory: %w", err)
+	}
+	defer unlock()
 
-		oldName := filename[:len(filename)-4]
-		currNum := strings.Split(oldName, "_")[1]
-		num, err := strconv.Atoi(currNum)
+	seq := 1
+	if ok {
+		latest, err := latestLogFileSeq(l.LogDir, date, l.SeqPadding)
 		if err != nil {
 			return err
 		}
-		newFileName = fmt.Sprintf(`%s_%d.log`, date, num+1)
+		seq = max(latest, num+1)
 	}
 
+	newFileName := formatLogFileName(date, seq, l.SeqPadding)
 	logFile, err := os.OpenFile(filepath.Join(l.LogDir, newFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
 		return err
 	}
-	l.CurrentLogFile = logFile
-	l.FileLog = log.New(logFile, "", log.LstdFlags)
+
+	oldPath := l.CurrentLogFile.Name()
+	if err = l.setLogFile(logFile); err != nil {
+		return err
+	}
+	if l.DeduplicateAcrossProcesses {
+		removeDedupFilter(oldPath)
+	}
+	if l.CompressRotated {
+		l.queueCompression(oldPath)
+	}
+
+	if l.OnAfterRotate != nil {
+		l.OnAfterRotate(oldPath, logFile.Name())
+	}
 	return nil
 }
 
-func getUserLogFile(logDir string) (*os.File, error) {
-	files, err := os.ReadDir(logDir)
+// setLogFile makes logFile the current log file, wrapping it according to the logger options.
+func (l *FileLogger) setLogFile(logFile *os.File) error {
+	fileLog, err := l.newFileLog(logFile)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	now := time.Now()
-	y, m, d := now.Date()
-	date := fmt.Sprintf(`%d-%d-%d`, y, m, d)
-
-	var filteredFiles []string
+	closeDedupFilter(l.FileLog)
+	l.CurrentLogFile = logFile
+	l.FileLog = fileLog
+	l.linesWritten.Store(0)
+	return nil
+}
 
-	for _, f := range files {
-		filename := f.Name()
-		if strings.HasPrefix(filename, date) {
-			filteredFiles = append(filteredFiles, filename[:len(filename)-4])
+// newFileLog creates the logger used to write to w, wrapping w according to the logger options.
+func (l *FileLogger) newFileLog(target io.Writer) (*log.Logger, error) {
+	w := target
+	if !isUTF8(l.TextEncoding) {
+		if len(l.EncryptionKey) > 0 {
+			return nil, errors.New("encrypted log files must be UTF-8 encoded")
 		}
+		encoded, err := newEncodingWriter(w, l.TextEncoding)
+		if err != nil {
+			return nil, err
+		}
+		w = encoded
+	}
+	if len(l.EncryptionKey) > 0 {
+		encrypted, err := NewEncryptingWriter(w, l.EncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		w = encrypted
+	}
+	if l.ring != nil {
+		w = io.MultiWriter(w, l.ring)
+	}
+	if l.WriteChecksum {
+		checksummed, err := newChecksumWriter(w, l.ChecksumAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		w = checksummed
+	}
+	if file, ok := target.(*os.File); ok && l.DeduplicateAcrossProcesses {
+		deduped, err := newDedupWriter(w, file.Name(), l.bloomCapacity(), l.BloomFalsePositiveRate, &l.duplicates)
+		if err != nil {
+			return nil, err
+		}
+		w = deduped
 	}
 
-	var logFileName string
+	return log.New(w, l.linePrefix(), log.LstdFlags|log.Lmsgprefix), nil
+}
 
-	if len(filteredFiles) > 0 {
-		logFileName = filteredFiles[0]
-		for i := 1; i < len(filteredFiles); i++ {
-			latestNum := strings.Split(logFileName, "_")[1]
-			currentNum := strings.Split(filteredFiles[i], "_")[1]
-			if currentNum > latestNum {
-				logFileName = filteredFiles[i]
-			}
-		}
-	} else {
-		logFileName = fmt.Sprintf(`%s_1`, date)
+// closeCurrentFile syncs the current log file and closes it, unless it was provided through SetPrimaryOutput.
+func (l *FileLogger) closeCurrentFile() error {
+	closeDedupFilter(l.FileLog)
+	if l.CurrentLogFile == nil {
+		return nil
 	}
 
-	logFileName = fmt.Sprintf(`%s.log`, logFileName)
+	l.CurrentLogFile.Sync()
+	if l.externalFile {
+		return nil
+	}
+	return l.CurrentLogFile.Close()
+}
+
+func getUserLogFile(logDir string, seqPadding int, rotation RotationPolicy, pid int) (*os.File, error) {
+	return openLogFile(logDir, logFilePrefix(time.Now(), rotation, pid), seqPadding, 0)
+}
+
+// getNextLogFile creates the log file of the current period following the latest one, e.g. for a logger that must
+// not share it.
+func getNextLogFile(logDir string, seqPadding int, rotation RotationPolicy, pid int) (*os.File, error) {
+	return openLogFile(logDir, logFilePrefix(time.Now(), rotation, pid), seqPadding, 1)
+}
+
+// openLogFile opens the log file of the period, a day or week as returned by logFilePeriod, whose sequence number is
+// offset from the latest existing one. The log directory is locked meanwhile, so that concurrent processes agree on
+// the latest file.
+func openLogFile(logDir, date string, seqPadding, offset int) (*os.File, error) {
+	unlock, err := lockLogDir(logDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed locking log directory: %w", err)
+	}
+	defer unlock()
+
+	latestNum, err := latestLogFileSeq(logDir, date, seqPadding)
+	if err != nil {
+		return nil, err
+	}
+
+	logFileName := formatLogFileName(date, latestNum+offset, seqPadding)
 	logFile, err := os.OpenFile(filepath.Join(logDir, logFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
 		return nil, err
@@ -184,3 +829,45 @@ func getUserLogFile(logDir string) (*os.File, error) {
 
 	return logFile, nil
 }
+
+// latestLogFileSeq returns the highest sequence number of the log files of the period in logDir, or 1 if there are
+// none. The log directory must be locked.
+func latestLogFileSeq(logDir, date string, seqPadding int) (int, error) {
+	files, err := os.ReadDir(logDir)
+	if err != nil {
+		return 0, err
+	}
+
+	latestNum := 1
+	for _, f := range files {
+		if num, ok := parseLogFileSeq(f.Name(), date, seqPadding); ok && num > latestNum {
+			latestNum = num
+		}
+	}
+	return latestNum, nil
+}
+
+// formatLogFileName returns the name of the log file with the given date and sequence number,
+// zero-padding the sequence number to seqPadding digits.
+func formatLogFileName(date string, seq, seqPadding int) string {
+	return fmt.Sprintf(`%s_%0*d.log`, date, seqPadding, seq)
+}
+
+// parseLogFileSeq returns the sequence number of filename if it is a log file of the given date named with seqPadding.
+// Files named with a different padding do not match.
+func parseLogFileSeq(filename, date string, seqPadding int) (int, bool) {
+	seq, found := strings.CutPrefix(filename, date+"_")
+	if !found {
+		return 0, false
+	}
+	seq, found = strings.CutSuffix(seq, ".log")
+	if !found {
+		return 0, false
+	}
+
+	num, err := strconv.Atoi(seq)
+	if err != nil || num < 1 || formatLogFileName(date, num, seqPadding) != filename {
+		return 0, false
+	}
+	return num, true
+}
@@ -1,10 +1,17 @@
 package logger
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -79,6 +86,11 @@ func TestGetUserLogFile(t *testing.T) {
 			existingFiles:    []string{fmt.Sprintf("%s_1.log", prevDate), fmt.Sprintf("%s_2.log", prevDate), fmt.Sprintf("%s_1.log", date), fmt.Sprintf("%s_2.log", date), fmt.Sprintf("%s_3.log", date)},
 			expectedFilename: fmt.Sprintf("%s_3.log", date),
 		},
+		{
+			name:             "more than nine files",
+			existingFiles:    []string{fmt.Sprintf("%s_9.log", date), fmt.Sprintf("%s_10.log", date), fmt.Sprintf("%s_2.log", date)},
+			expectedFilename: fmt.Sprintf("%s_10.log", date),
+		},
 	}
 
 	for _, tt := range tests {
@@ -89,7 +101,7 @@ func TestGetUserLogFile(t *testing.T) {
 				t.Errorf("failed to create test files: %s", err)
 			}
 
-			logFile, err := getUserLogFile(testLogDir)
+			logFile, err := getUserLogFile(testLogDir, 0, RotateDaily, 0)
 			if err != nil {
 				t.Errorf("failed to get user log file: %s", err)
 			}
@@ -123,10 +135,10 @@ func TestRefreshLogFile(t *testing.T) {
 
 	type LoggerTest struct {
 		name           string
-		initialLogger  FileLogger
-		expectedLogger FileLogger
+		initialLogger  *FileLogger
+		expectedLogger *FileLogger
 	}
-	var tests [3]LoggerTest
+	var tests [3]*LoggerTest
 
 	// Test case 1
 	initialFilePath := filepath.Join(testLogDir, fmt.Sprintf("%s_1.log", prevDate))
@@ -143,20 +155,22 @@ func TestRefreshLogFile(t *testing.T) {
 
 	test1 := &LoggerTest{
 		name: "new log file on a new day",
-		initialLogger: FileLogger{
-			DevMode:        false,
-			LogDir:         testLogDir,
-			CurrentLogFile: initFile1,
-			FileLog:        log.New(initFile1, "", log.LstdFlags),
+		initialLogger: &FileLogger{
+			DevMode:          false,
+			LogDir:           testLogDir,
+			CurrentLogFile:   initFile1,
+			FileLog:          log.New(initFile1, "", log.LstdFlags),
+			MaxFileSizeBytes: defaultMaxFileSizeBytes,
 		},
-		expectedLogger: FileLogger{
-			DevMode:        false,
-			LogDir:         testLogDir,
-			CurrentLogFile: expetedFile1,
-			FileLog:        log.New(expetedFile1, "", log.LstdFlags),
+		expectedLogger: &FileLogger{
+			DevMode:          false,
+			LogDir:           testLogDir,
+			CurrentLogFile:   expetedFile1,
+			FileLog:          log.New(expetedFile1, "", log.LstdFlags),
+			MaxFileSizeBytes: defaultMaxFileSizeBytes,
 		},
 	}
-	tests[0] = *test1
+	tests[0] = test1
 
 	// Test case 2
 	initialFilePath = filepath.Join(testLogDir, fmt.Sprintf("%s_1.log", date))
@@ -169,19 +183,24 @@ func TestRefreshLogFile(t *testing.T) {
 		t.Errorf("failed to resize file: %s", err)
 	}
 
-	logger := &FileLogger{
-		DevMode:        false,
-		LogDir:         testLogDir,
-		CurrentLogFile: initFile2,
-		FileLog:        log.New(initFile2, "", log.LstdFlags),
-	}
-
 	test2 := &LoggerTest{
-		name:           "no new file if size is less than 10MB",
-		initialLogger:  *logger,
-		expectedLogger: *logger,
+		name: "no new file if size is less than 10MB",
+		initialLogger: &FileLogger{
+			DevMode:          false,
+			LogDir:           testLogDir,
+			CurrentLogFile:   initFile2,
+			FileLog:          log.New(initFile2, "", log.LstdFlags),
+			MaxFileSizeBytes: defaultMaxFileSizeBytes,
+		},
+		expectedLogger: &FileLogger{
+			DevMode:          false,
+			LogDir:           testLogDir,
+			CurrentLogFile:   initFile2,
+			FileLog:          log.New(initFile2, "", log.LstdFlags),
+			MaxFileSizeBytes: defaultMaxFileSizeBytes,
+		},
 	}
-	tests[1] = *test2
+	tests[1] = test2
 
 	// Test case 3
 	initialFilePath = filepath.Join(testLogDir, fmt.Sprintf("%s_2.log", date))
@@ -202,20 +221,22 @@ func TestRefreshLogFile(t *testing.T) {
 
 	test3 := &LoggerTest{
 		name: "new file if size exceeds 10MB",
-		initialLogger: FileLogger{
-			DevMode:        false,
-			LogDir:         testLogDir,
-			CurrentLogFile: initFile3,
-			FileLog:        log.New(initFile3, "", log.LstdFlags),
+		initialLogger: &FileLogger{
+			DevMode:          false,
+			LogDir:           testLogDir,
+			CurrentLogFile:   initFile3,
+			FileLog:          log.New(initFile3, "", log.LstdFlags),
+			MaxFileSizeBytes: defaultMaxFileSizeBytes,
 		},
-		expectedLogger: FileLogger{
-			DevMode:        false,
-			LogDir:         testLogDir,
-			CurrentLogFile: expetedFile3,
-			FileLog:        log.New(expetedFile3, "", log.LstdFlags),
+		expectedLogger: &FileLogger{
+			DevMode:          false,
+			LogDir:           testLogDir,
+			CurrentLogFile:   expetedFile3,
+			FileLog:          log.New(expetedFile3, "", log.LstdFlags),
+			MaxFileSizeBytes: defaultMaxFileSizeBytes,
 		},
 	}
-	tests[2] = *test3
+	tests[2] = test3
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -235,3 +256,684 @@ func TestRefreshLogFile(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxFileSizeBytes(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name        string
+		opts        []Option
+		initialSize int64
+		expectedSeq string
+	}{
+		{name: "below the limit", opts: []Option{WithMaxFileSizeBytes(1000)}, initialSize: 999, expectedSeq: "_1.log"},
+		{name: "at the limit", opts: []Option{WithMaxFileSizeBytes(1000)}, initialSize: 1000, expectedSeq: "_2.log"},
+		{name: "default limit", initialSize: 10000001, expectedSeq: "_2.log"},
+		{name: "disabled", opts: []Option{WithMaxFileSizeBytes(0)}, initialSize: 20000000, expectedSeq: "_1.log"},
+		{name: "negative", opts: []Option{WithMaxFileSizeBytes(-1)}, initialSize: 20000000, expectedSeq: "_1.log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, tt.opts...)
+			if err := logger.CurrentLogFile.Truncate(tt.initialSize); err != nil {
+				t.Fatalf("failed to resize file: %s", err)
+			}
+
+			logger.LogInfo("line")
+
+			if name := logger.CurrentLogFile.Name(); !strings.HasSuffix(name, tt.expectedSeq) {
+				t.Errorf("expected log file ending with %s; got %s", tt.expectedSeq, name)
+			}
+		})
+	}
+}
+
+func TestRotationJoinsNewerFile(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMaxLinesPerFile(1))
+	logger.LogInfo("first")
+
+	// Another process sharing the directory rotated to the third file meanwhile.
+	date := logFileNamePrefix(filepath.Base(logger.CurrentLogFile.Name()))
+	other := filepath.Join(logger.LogDir, formatLogFileName(date, 3, 0))
+	if err := os.WriteFile(other, []byte("from another process\n"), 0666); err != nil {
+		t.Fatalf("failed to create file: %s", err)
+	}
+
+	logger.LogInfo("second")
+
+	if logger.CurrentLogFile.Name() != other {
+		t.Errorf("expected the logger to join %s; got %s", other, logger.CurrentLogFile.Name())
+	}
+	if _, err := os.Stat(filepath.Join(logger.LogDir, formatLogFileName(date, 2, 0))); !os.IsNotExist(err) {
+		t.Errorf("expected no file to be created behind the latest one; got %v", err)
+	}
+}
+
+func TestNewLoggerWithPath(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name    string
+		logDir  string
+		wantErr bool
+	}{
+		{name: "new nested directory", logDir: filepath.Join(t.TempDir(), "var", "log", "myapp")},
+		{name: "existing directory", logDir: t.TempDir()},
+		{name: "relative path", logDir: filepath.Join("var", "log", "myapp"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, err := NewLoggerWithPath(false, tt.logDir, LogLevelWarn)
+			if tt.wantErr {
+				if err == nil {
+					logger.Close()
+					t.Errorf("expected an error for %s", tt.logDir)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to create logger: %s", err)
+			}
+			defer logger.Close()
+
+			logger.LogInfo("filtered")
+			logger.LogWarn("written")
+
+			if dir := filepath.Dir(logger.CurrentLogFile.Name()); dir != tt.logDir {
+				t.Errorf("expected log file in %s; got %s", tt.logDir, dir)
+			}
+			content := readLogFile(t, logger)
+			if strings.Contains(content, "filtered") || !strings.Contains(content, "WARNING written") {
+				t.Errorf("expected only the warning to be logged; got %q", content)
+			}
+		})
+	}
+}
+
+func TestWithDevMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		devMode  bool
+		opts     []Option
+		expected bool
+	}{
+		{name: "argument", devMode: true, expected: true},
+		{name: "option enables", opts: []Option{WithDevMode(true)}, expected: true},
+		{name: "option disables", devMode: true, opts: []Option{WithDevMode(false)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consoleOutput := captureConsole(t)
+
+			logger, err := NewLoggerWithPath(tt.devMode, t.TempDir(), LogLevelDebug, tt.opts...)
+			if err != nil {
+				t.Fatalf("failed to create logger: %s", err)
+			}
+			defer logger.Close()
+
+			if logger.IsDevMode() != tt.expected {
+				t.Errorf("expected dev mode %t; got %t", tt.expected, logger.IsDevMode())
+			}
+			notice := strings.Contains(consoleOutput.String(), "INFO logger running in development mode")
+			if notice != tt.expected {
+				t.Errorf("expected the development mode notice %t; got %q", tt.expected, consoleOutput.String())
+			}
+		})
+	}
+}
+
+// captureConsole redirects the standard logger to a buffer for the duration of a test.
+func captureConsole(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	output, flags := log.Writer(), log.Flags()
+	log.SetOutput(&buf)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(output)
+		log.SetFlags(flags)
+	})
+	return &buf
+}
+
+// newTestLogger creates a FileLogger writing to a temporary directory that is closed when the test ends.
+func newTestLogger(t *testing.T, opts ...Option) *FileLogger {
+	logger, err := newLogger(false, t.TempDir(), opts...)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	t.Cleanup(func() { logger.Close() })
+	return logger
+}
+
+func TestConsoleColors(t *testing.T) {
+	defer func(fn func(io.Writer) bool) { isTerminal = fn }(isTerminal)
+
+	cs := DefaultColorScheme()
+	tests := []struct {
+		name     string
+		devMode  bool
+		terminal bool
+		log      func(l *FileLogger)
+		expected string
+	}{
+		{
+			name:     "info in dev mode",
+			devMode:  true,
+			terminal: true,
+			log:      func(l *FileLogger) { l.LogInfo("started") },
+			expected: cs.Info + "INFO" + colorReset + " started\n",
+		},
+		{
+			name:     "warning in dev mode",
+			devMode:  true,
+			terminal: true,
+			log:      func(l *FileLogger) { l.LogWarn("slow") },
+			expected: cs.Warn + "WARNING" + colorReset + " slow\n",
+		},
+		{
+			name:     "error in dev mode",
+			devMode:  true,
+			terminal: true,
+			log:      func(l *FileLogger) { l.LogError(errors.New("failed")) },
+			expected: cs.Error + "ERROR" + colorReset + " failed\n",
+		},
+		{
+			name:     "debug in dev mode",
+			devMode:  true,
+			terminal: true,
+			log:      func(l *FileLogger) { l.LogDebug("details") },
+			expected: cs.Debug + "DEBUG" + colorReset + " details\n",
+		},
+		{
+			name:     "not a terminal",
+			devMode:  true,
+			terminal: false,
+			log:      func(l *FileLogger) { l.LogInfo("started") },
+			expected: "INFO started\n",
+		},
+		{
+			name:     "production mode",
+			devMode:  false,
+			terminal: true,
+			log:      func(l *FileLogger) { l.LogInfo("started") },
+			expected: "INFO started\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isTerminal = func(io.Writer) bool { return tt.terminal }
+			console := captureConsole(t)
+
+			logger := newTestLogger(t)
+			logger.SetDevMode(tt.devMode)
+			logger.ColorScheme = cs
+			tt.log(logger)
+
+			if console.String() != tt.expected {
+				t.Errorf("expected console output %q; got %q", tt.expected, console.String())
+			}
+
+			content, err := os.ReadFile(logger.CurrentLogFile.Name())
+			if err != nil {
+				t.Fatalf("failed to read log file: %s", err)
+			}
+			if strings.Contains(string(content), "\033[") {
+				t.Errorf("expected no color codes in the log file; got %q", content)
+			}
+		})
+	}
+}
+
+func TestReplaceFile(t *testing.T) {
+	logger := newTestLogger(t)
+	oldPath := logger.CurrentLogFile.Name()
+	newPath := filepath.Join(t.TempDir(), "replaced.log")
+
+	var rotated [2]string
+	logger.OnAfterRotate = func(oldPath, newPath string) {
+		rotated = [2]string{oldPath, newPath}
+	}
+
+	const writers, linesPerWriter = 8, 200
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < linesPerWriter; i++ {
+				logger.LogDebug(fmt.Sprintf("writer %d line %d", w, i))
+			}
+		}(w)
+	}
+
+	time.Sleep(time.Millisecond)
+	if err := logger.ReplaceFile(newPath); err != nil {
+		t.Fatalf("failed to replace file: %s", err)
+	}
+	wg.Wait()
+	logger.LogDebug("after replace")
+
+	if rotated != [2]string{oldPath, newPath} {
+		t.Errorf("expected OnAfterRotate with %s and %s; got %v", oldPath, newPath, rotated)
+	}
+
+	oldContent, err := os.ReadFile(oldPath)
+	if err != nil {
+		t.Fatalf("failed to read old file: %s", err)
+	}
+	newContent, err := os.ReadFile(newPath)
+	if err != nil {
+		t.Fatalf("failed to read new file: %s", err)
+	}
+
+	total := strings.Count(string(oldContent), "\n") + strings.Count(string(newContent), "\n")
+	if total != writers*linesPerWriter+1 {
+		t.Errorf("expected %d lines across both files; got %d", writers*linesPerWriter+1, total)
+	}
+	if !strings.Contains(string(newContent), "after replace") {
+		t.Errorf("expected writes after ReplaceFile to go to the new file")
+	}
+}
+
+func TestReplaceFileError(t *testing.T) {
+	logger := newTestLogger(t)
+	oldPath := logger.CurrentLogFile.Name()
+
+	err := logger.ReplaceFile(filepath.Join(t.TempDir(), "missing", "replaced.log"))
+	if err == nil {
+		t.Fatalf("expected an error replacing with a path in a missing directory")
+	}
+
+	logger.LogDebug("still writing")
+	content, err := os.ReadFile(oldPath)
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	if !strings.Contains(string(content), "still writing") {
+		t.Errorf("expected the original file to keep receiving writes")
+	}
+}
+
+func TestClose(t *testing.T) {
+	logger, err := newLogger(false, t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+
+	if !logger.LastWriteTime().IsZero() {
+		t.Errorf("expected a zero last write time before any write")
+	}
+	logger.LogDebug("before close")
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+	if err := logger.Close(); err != nil {
+		t.Errorf("expected closing twice to succeed; got %s", err)
+	}
+	logger.LogDebug("after close")
+
+	content, err := os.ReadFile(logger.CurrentLogFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	if strings.Contains(string(content), "after close") {
+		t.Errorf("expected no file writes after Close")
+	}
+}
+
+// readLogFile returns the content of the current log file of logger.
+func readLogFile(t *testing.T, logger *FileLogger) string {
+	content, err := os.ReadFile(logger.CurrentLogFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	return string(content)
+}
+
+// readLogDir returns the entries of logDir except the lock file guarding log file creation.
+func readLogDir(t *testing.T, logDir string) []os.DirEntry {
+	entries, err := os.ReadDir(logDir)
+	if err != nil {
+		t.Fatalf("failed to read log directory: %s", err)
+	}
+	return slices.DeleteFunc(entries, func(e os.DirEntry) bool { return e.Name() == lockFileName })
+}
+
+func TestSetPrimaryOutput(t *testing.T) {
+	logger := newTestLogger(t)
+	originalPath := logger.CurrentLogFile.Name()
+
+	logger.LogDebug("before switch")
+	var buf bytes.Buffer
+	if err := logger.SetPrimaryOutput(&buf); err != nil {
+		t.Fatalf("failed to set primary output: %s", err)
+	}
+	logger.LogDebug("after switch")
+
+	original, err := os.ReadFile(originalPath)
+	if err != nil {
+		t.Fatalf("failed to read original file: %s", err)
+	}
+	if !strings.Contains(string(original), "before switch") || strings.Contains(string(original), "after switch") {
+		t.Errorf("expected only the first line in the original file; got %q", original)
+	}
+	if strings.Contains(buf.String(), "before switch") || !strings.Contains(buf.String(), "after switch") {
+		t.Errorf("expected only the second line in the new output; got %q", buf.String())
+	}
+	if logger.CurrentLogFile != nil {
+		t.Errorf("expected no current log file for a non-file output")
+	}
+}
+
+func TestSetPrimaryOutputFile(t *testing.T) {
+	logger := newTestLogger(t)
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "production.log"))
+	if err != nil {
+		t.Fatalf("failed to create file: %s", err)
+	}
+	defer file.Close()
+
+	if err := logger.SetPrimaryOutput(file); err != nil {
+		t.Fatalf("failed to set primary output: %s", err)
+	}
+	if err := logger.SetPrimaryOutput(file); err != nil {
+		t.Fatalf("failed to set the same output twice: %s", err)
+	}
+	logger.LogDebug("to the new file")
+
+	if logger.CurrentLogFile != file {
+		t.Errorf("expected the current log file to be the new file")
+	}
+	if content := readLogFile(t, logger); strings.Count(content, "to the new file") != 1 {
+		t.Errorf("expected exactly one line in the new file; got %q", content)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+	if _, err := file.Write([]byte("still open\n")); err != nil {
+		t.Errorf("expected Close to leave a caller provided file open; got %s", err)
+	}
+}
+
+func TestGetUserLogFileSeqPadding(t *testing.T) {
+	now := time.Now()
+	y, m, d := now.Date()
+	date := fmt.Sprintf(`%d-%d-%d`, y, m, d)
+
+	tests := []struct {
+		name             string
+		seqPadding       int
+		existingFiles    []string
+		expectedFilename string
+	}{
+		{
+			name:             "no existing files",
+			seqPadding:       3,
+			expectedFilename: fmt.Sprintf("%s_001.log", date),
+		},
+		{
+			name:             "padded files",
+			seqPadding:       3,
+			existingFiles:    []string{fmt.Sprintf("%s_001.log", date), fmt.Sprintf("%s_012.log", date), fmt.Sprintf("%s_002.log", date)},
+			expectedFilename: fmt.Sprintf("%s_012.log", date),
+		},
+		{
+			name:             "unpadded files are ignored",
+			seqPadding:       3,
+			existingFiles:    []string{fmt.Sprintf("%s_7.log", date), fmt.Sprintf("%s_002.log", date)},
+			expectedFilename: fmt.Sprintf("%s_002.log", date),
+		},
+		{
+			name:             "padded files are ignored without padding",
+			seqPadding:       0,
+			existingFiles:    []string{fmt.Sprintf("%s_005.log", date), fmt.Sprintf("%s_2.log", date)},
+			expectedFilename: fmt.Sprintf("%s_2.log", date),
+		},
+		{
+			name:             "sequence wider than the padding",
+			seqPadding:       2,
+			existingFiles:    []string{fmt.Sprintf("%s_99.log", date), fmt.Sprintf("%s_100.log", date)},
+			expectedFilename: fmt.Sprintf("%s_100.log", date),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testLogDir := t.TempDir()
+			if err := createTestFiles(testLogDir, tt.existingFiles); err != nil {
+				t.Fatalf("failed to create test files: %s", err)
+			}
+
+			logFile, err := getUserLogFile(testLogDir, tt.seqPadding, RotateDaily, 0)
+			if err != nil {
+				t.Fatalf("failed to get user log file: %s", err)
+			}
+			defer logFile.Close()
+
+			if actual := filepath.Base(logFile.Name()); actual != tt.expectedFilename {
+				t.Errorf("expected log file name %s; got %s", tt.expectedFilename, actual)
+			}
+		})
+	}
+}
+
+func TestSeqPaddingSortOrder(t *testing.T) {
+	logDir := t.TempDir()
+	logger := &FileLogger{LogDir: logDir, SeqPadding: 3, MaxFileSizeBytes: 1000}
+
+	logFile, err := getUserLogFile(logDir, logger.SeqPadding, logger.Rotation, 0)
+	if err != nil {
+		t.Fatalf("failed to get user log file: %s", err)
+	}
+	if err := logger.setLogFile(logFile); err != nil {
+		t.Fatalf("failed to set log file: %s", err)
+	}
+
+	var created []string
+	for i := 0; i < 12; i++ {
+		created = append(created, filepath.Base(logger.CurrentLogFile.Name()))
+		if err := logger.CurrentLogFile.Truncate(1000); err != nil {
+			t.Fatalf("failed to resize file: %s", err)
+		}
+		if err := logger.refreshLogFile(); err != nil {
+			t.Fatalf("failed to refresh log file: %s", err)
+		}
+	}
+	logger.CurrentLogFile.Close()
+
+	entries := readLogDir(t, logDir)
+	if len(entries) != 13 {
+		t.Fatalf("expected 13 log files; got %d", len(entries))
+	}
+	for i, name := range created {
+		if entries[i].Name() != name {
+			t.Errorf("expected file %d in name order to be %s; got %s", i, name, entries[i].Name())
+		}
+	}
+}
+
+func TestMaxLinesPerFile(t *testing.T) {
+	captureConsole(t)
+	const maxLines = 5
+	logger := newTestLogger(t, WithMaxLinesPerFile(maxLines))
+
+	for i := 0; i < maxLines+1; i++ {
+		logger.LogInfo(fmt.Sprintf("line %d", i))
+	}
+
+	entries := readLogDir(t, logger.LogDir)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log files; got %d", len(entries))
+	}
+
+	expectedLines := []int{maxLines, 1}
+	for i, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(logger.LogDir, entry.Name()))
+		if err != nil {
+			t.Fatalf("failed to read log file: %s", err)
+		}
+		if lines := strings.Count(string(content), "\n"); lines != expectedLines[i] {
+			t.Errorf("expected %d lines in %s; got %d", expectedLines[i], entry.Name(), lines)
+		}
+	}
+}
+
+func BenchmarkLogToFile(b *testing.B) {
+	benchmarks := []struct {
+		name     string
+		maxLines int64
+	}{
+		{name: "without line limit", maxLines: 0},
+		{name: "with line limit", maxLines: math.MaxInt64},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			logger, err := newLogger(false, b.TempDir(), WithMaxLinesPerFile(bm.maxLines))
+			if err != nil {
+				b.Fatalf("failed to create logger: %s", err)
+			}
+			defer logger.Close()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				logger.logToFile("INFO benchmark line")
+			}
+		})
+	}
+}
+
+func TestSetPrefix(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithPrefix("tenant-a "))
+
+	logger.LogInfo("before")
+	logger.SetPrefix("tenant-b ")
+	logger.LogInfo("after")
+
+	if logger.Prefix() != "tenant-b " {
+		t.Errorf("expected prefix %q; got %q", "tenant-b ", logger.Prefix())
+	}
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	expected := []string{"tenant-a INFO before", "tenant-b INFO after"}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines; got %q", len(expected), lines)
+	}
+	for i, line := range lines {
+		if line[timestampLength:] != expected[i] {
+			t.Errorf("expected line %d to be %q after the timestamp; got %q", i, expected[i], line)
+		}
+	}
+}
+
+func TestSetDevMode(t *testing.T) {
+	consoleOutput := captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogDebug("hidden")
+	logger.SetDevMode(true)
+	logger.LogDebug("shown")
+
+	if !logger.IsDevMode() {
+		t.Errorf("expected dev mode to be on")
+	}
+	if consoleOutput.String() != "DEBUG shown\n" {
+		t.Errorf("expected only the debug message logged in dev mode on the console; got %q", consoleOutput.String())
+	}
+}
+
+// countingStringer counts how many times it is formatted.
+type countingStringer struct {
+	calls int
+}
+
+func (c *countingStringer) String() string {
+	c.calls++
+	return "formatted"
+}
+
+func TestPrintfMethods(t *testing.T) {
+	captureConsole(t)
+	errNotFound := errors.New("not found")
+
+	tests := []struct {
+		name     string
+		log      func(l *FileLogger)
+		expected string
+	}{
+		{
+			name:     "info",
+			log:      func(l *FileLogger) { l.LogInfof("user %d logged in from %s", 7, "10.0.0.1") },
+			expected: "INFO user 7 logged in from 10.0.0.1\n",
+		},
+		{
+			name:     "warn",
+			log:      func(l *FileLogger) { l.LogWarnf("disk usage at %d%%", 80) },
+			expected: "WARNING disk usage at 80%\n",
+		},
+		{
+			name:     "debug",
+			log:      func(l *FileLogger) { l.LogDebugf("cache %s", "miss") },
+			expected: "DEBUG cache miss\n",
+		},
+		{
+			name:     "error wrapping",
+			log:      func(l *FileLogger) { l.LogErrorf("failed loading user %d: %w", 7, errNotFound) },
+			expected: "ERROR failed loading user 7: not found\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t)
+
+			tt.log(logger)
+
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected line ending with %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestPrintfMethodsDisabledLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelWarn))
+	arg := &countingStringer{}
+
+	logger.LogDebugf("value %s", arg)
+	logger.LogInfof("value %s", arg)
+	if arg.calls != 0 {
+		t.Errorf("expected disabled levels not to format their arguments; got %d calls", arg.calls)
+	}
+
+	logger.LogWarnf("value %s", arg)
+	if arg.calls != 1 {
+		t.Errorf("expected the warning to be formatted once; got %d calls", arg.calls)
+	}
+	if content := readLogFile(t, logger); strings.Count(content, "\n") != 1 {
+		t.Errorf("expected only the warning to be logged; got %q", content)
+	}
+}
+
+func TestErrorfLevelRules(t *testing.T) {
+	captureConsole(t)
+	errNotFound := errors.New("not found")
+	logger := newTestLogger(t)
+	logger.RegisterErrorLevel(func(err error) bool { return errors.Is(err, errNotFound) }, LogLevelInfo)
+
+	logger.LogErrorf("user %d: %w", 7, errNotFound)
+
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, "INFO user 7: not found\n") {
+		t.Errorf("expected the wrapped error to be logged at its rule level; got %q", content)
+	}
+}
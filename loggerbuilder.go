@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LoggerBuilder builds FileLogger instances from a LoggerConfig set through chained setters.
+// It marshals to and from the JSON form of LoggerConfig, so that logger configurations can be stored and loaded:
+//
+//	l, err := logger.NewLoggerBuilder().Dir("/var/log/myapp").Level(logger.LogLevelWarn).Build()
+type LoggerBuilder struct {
+	cfg LoggerConfig
+}
+
+// NewLoggerBuilder returns a builder starting from DefaultLoggerConfig.
+func NewLoggerBuilder() *LoggerBuilder {
+	return &LoggerBuilder{cfg: DefaultLoggerConfig()}
+}
+
+// Dir sets the directory where the log files are written.
+func (b *LoggerBuilder) Dir(dir string) *LoggerBuilder {
+	b.cfg.LogDir = dir
+	return b
+}
+
+// Level sets the lowest level that is logged.
+func (b *LoggerBuilder) Level(level LogLevel) *LoggerBuilder {
+	b.cfg.MinLevel = level
+	return b
+}
+
+// DevMode sets whether the logger runs in development mode, which also writes DEBUG messages to the console.
+func (b *LoggerBuilder) DevMode(dev bool) *LoggerBuilder {
+	b.cfg.DevMode = dev
+	return b
+}
+
+// Prefix sets the prefix written between the timestamp and the level of every log file line.
+func (b *LoggerBuilder) Prefix(prefix string) *LoggerBuilder {
+	b.cfg.Prefix = prefix
+	return b
+}
+
+// Hostname sets whether the host name is written in front of every log file line.
+func (b *LoggerBuilder) Hostname(enabled bool) *LoggerBuilder {
+	b.cfg.IncludeHostname = enabled
+	return b
+}
+
+// MaxFileSizeBytes sets the size at which the log file is rotated; zero or a negative n disables size based rotation.
+func (b *LoggerBuilder) MaxFileSizeBytes(n int64) *LoggerBuilder {
+	b.cfg.MaxFileSizeBytes = n
+	return b
+}
+
+// MaxSizeMB sets the size at which the log file is rotated in megabytes of 1,000,000 bytes, like MaxFileSizeBytes.
+func (b *LoggerBuilder) MaxSizeMB(mb int64) *LoggerBuilder {
+	return b.MaxFileSizeBytes(mb * 1000000)
+}
+
+// Compress sets whether every log file is compressed once the logger rotates away from it; see WithCompressRotated.
+func (b *LoggerBuilder) Compress(enabled bool) *LoggerBuilder {
+	b.cfg.CompressRotated = enabled
+	return b
+}
+
+// TextEncoding sets the encoding of the log files: "utf-8", the default, "utf-16le" or "utf-16be"; see
+// WithTextEncoding.
+func (b *LoggerBuilder) TextEncoding(enc string) *LoggerBuilder {
+	b.cfg.TextEncoding = enc
+	return b
+}
+
+// Config returns a copy of the configuration built so far.
+func (b *LoggerBuilder) Config() LoggerConfig {
+	return b.cfg
+}
+
+// Build creates a FileLogger from the configuration, like NewLoggerFromFile does for a config file.
+func (b *LoggerBuilder) Build() (*FileLogger, error) {
+	return b.cfg.newLogger()
+}
+
+// MustBuild is like Build but panics if the logger cannot be created.
+func (b *LoggerBuilder) MustBuild() *FileLogger {
+	l, err := b.Build()
+	if err != nil {
+		panic(fmt.Sprintf("failed building logger: %s", err.Error()))
+	}
+	return l
+}
+
+// MarshalJSON encodes the configuration of b like LoggerConfig, in the form read by UnmarshalJSON and NewLoggerFromFile.
+func (b *LoggerBuilder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.cfg)
+}
+
+// UnmarshalJSON replaces the configuration of b; keys missing from data keep their DefaultLoggerConfig values.
+func (b *LoggerBuilder) UnmarshalJSON(data []byte) error {
+	cfg := DefaultLoggerConfig()
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+	b.cfg = cfg
+	return nil
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoggerBuilderRoundTrip(t *testing.T) {
+	b := NewLoggerBuilder().
+		Dir("/var/log/myapp").
+		Level(LogLevelWarn).
+		DevMode(true).
+		Prefix("tenant=acme").
+		Hostname(true).
+		MaxFileSizeBytes(1 << 20).
+		Compress(true).
+		TextEncoding("utf-16le")
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("failed to marshal builder: %s", err)
+	}
+
+	loaded := new(LoggerBuilder)
+	if err := json.Unmarshal(data, loaded); err != nil {
+		t.Fatalf("failed to unmarshal builder: %s", err)
+	}
+	if !reflect.DeepEqual(loaded.Config(), b.Config()) {
+		t.Errorf("expected %+v; got %+v", b.Config(), loaded.Config())
+	}
+
+	cfg := loaded.Config()
+	if cfg.LogDir != "/var/log/myapp" || cfg.MinLevel != LogLevelWarn || !cfg.DevMode || cfg.Prefix != "tenant=acme" ||
+		!cfg.IncludeHostname || cfg.MaxFileSizeBytes != 1<<20 || !cfg.CompressRotated || cfg.TextEncoding != "utf-16le" {
+		t.Errorf("expected every setter to be kept; got %+v", cfg)
+	}
+}
+
+func TestLoggerBuilderMaxSizeMB(t *testing.T) {
+	if got := NewLoggerBuilder().MaxSizeMB(25).Config().MaxFileSizeBytes; got != 25000000 {
+		t.Errorf("expected 25000000 bytes; got %d", got)
+	}
+	if got := NewLoggerBuilder().Config().MaxFileSizeBytes; got != defaultMaxFileSizeBytes {
+		t.Errorf("expected the default of %d bytes; got %d", defaultMaxFileSizeBytes, got)
+	}
+}
+
+func TestLoggerBuilderUnmarshalDefaults(t *testing.T) {
+	loaded := new(LoggerBuilder)
+	if err := json.Unmarshal([]byte(`{"log_dir": "/tmp/logs", "min_level": "error"}`), loaded); err != nil {
+		t.Fatalf("failed to unmarshal builder: %s", err)
+	}
+
+	expected := DefaultLoggerConfig()
+	expected.LogDir = "/tmp/logs"
+	expected.MinLevel = LogLevelError
+	if !reflect.DeepEqual(loaded.Config(), expected) {
+		t.Errorf("expected %+v; got %+v", expected, loaded.Config())
+	}
+}
+
+func TestLoggerBuilderBuild(t *testing.T) {
+	captureConsole(t)
+	logger, err := NewLoggerBuilder().Dir(t.TempDir()).Level(LogLevelWarn).Prefix("[svc] ").Build()
+	if err != nil {
+		t.Fatalf("failed to build logger: %s", err)
+	}
+	defer logger.Close()
+
+	logger.LogInfo("dropped")
+	logger.LogWarn("kept")
+	content := readLogFile(t, logger)
+	if strings.Contains(content, "dropped") || !strings.Contains(content, "[svc] WARNING kept") {
+		t.Errorf("expected the builder settings to apply; got %q", content)
+	}
+}
+
+func TestLoggerBuilderMustBuild(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected MustBuild to panic without a log directory")
+		}
+	}()
+	NewLoggerBuilder().MustBuild()
+}
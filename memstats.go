@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// memStatsFields maps the runtime.MemStats fields LogMemStats can write to their values.
+var memStatsFields = map[string]func(*runtime.MemStats) uint64{
+	"HeapAlloc":    func(m *runtime.MemStats) uint64 { return m.HeapAlloc },
+	"HeapSys":      func(m *runtime.MemStats) uint64 { return m.HeapSys },
+	"HeapInuse":    func(m *runtime.MemStats) uint64 { return m.HeapInuse },
+	"StackInuse":   func(m *runtime.MemStats) uint64 { return m.StackInuse },
+	"GCSys":        func(m *runtime.MemStats) uint64 { return m.GCSys },
+	"NumGC":        func(m *runtime.MemStats) uint64 { return uint64(m.NumGC) },
+	"PauseTotalNs": func(m *runtime.MemStats) uint64 { return m.PauseTotalNs },
+}
+
+var defaultMemStatsKeys = []string{"HeapAlloc", "HeapSys", "HeapInuse", "StackInuse", "GCSys", "NumGC", "PauseTotalNs"}
+
+// WithMemStatsKeys restricts LogMemStats to the given runtime.MemStats fields, written in the given order.
+// The supported fields are HeapAlloc, HeapSys, HeapInuse, StackInuse, GCSys, NumGC and PauseTotalNs; others are ignored.
+func WithMemStatsKeys(keys ...string) Option {
+	return func(l *FileLogger) {
+		l.MemStatsKeys = keys
+	}
+}
+
+// LogMemStats logs a snapshot of runtime.MemStats at DEBUG level as `MEM <key>=<val>` pairs on one line,
+// with the fields in MemStatsKeys or, if it is empty, all the supported fields.
+func (l *FileLogger) LogMemStats() {
+	if !l.enabled(LogLevelDebug) {
+		return
+	}
+
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	l.logAt(LogLevelDebug, formatMemStats(&m, l.MemStatsKeys))
+}
+
+// StartPeriodicMemStats calls LogMemStats every interval until the logger is closed.
+func (l *FileLogger) StartPeriodicMemStats(interval time.Duration) {
+	l.goBackground(func(done <-chan struct{}) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				l.LogMemStats()
+			}
+		}
+	})
+}
+
+func formatMemStats(m *runtime.MemStats, keys []string) string {
+	if len(keys) == 0 {
+		keys = defaultMemStatsKeys
+	}
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := memStatsFields[key]; ok {
+			pairs = append(pairs, fmt.Sprintf("%s=%d", key, value(m)))
+		}
+	}
+	return fmt.Sprintf("MEM %s", strings.Join(pairs, " "))
+}
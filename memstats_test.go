@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatMemStats(t *testing.T) {
+	m := &runtime.MemStats{HeapAlloc: 1, HeapSys: 2, HeapInuse: 3, StackInuse: 4, GCSys: 5, NumGC: 6, PauseTotalNs: 7}
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected string
+	}{
+		{name: "all fields", expected: "MEM HeapAlloc=1 HeapSys=2 HeapInuse=3 StackInuse=4 GCSys=5 NumGC=6 PauseTotalNs=7"},
+		{name: "selected fields", keys: []string{"NumGC", "HeapAlloc"}, expected: "MEM NumGC=6 HeapAlloc=1"},
+		{name: "unknown field", keys: []string{"HeapAlloc", "Mallocs"}, expected: "MEM HeapAlloc=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatMemStats(m, tt.keys); got != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLogMemStats(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogMemStats()
+
+	content := readLogFile(t, logger)
+	for _, key := range defaultMemStatsKeys {
+		if !regexp.MustCompile(`DEBUG MEM .*\b` + key + `=\d+`).MatchString(content) {
+			t.Errorf("expected %s in %q", key, content)
+		}
+	}
+}
+
+func TestStartPeriodicMemStats(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMemStatsKeys("HeapAlloc"))
+
+	logger.StartPeriodicMemStats(20 * time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	content := readLogFile(t, logger)
+	if n := strings.Count(content, "DEBUG MEM HeapAlloc="); n < 2 {
+		t.Errorf("expected at least 2 snapshots within 100ms; got %d", n)
+	}
+	if strings.Contains(content, "NumGC") {
+		t.Errorf("expected only the configured keys; got %q", content)
+	}
+}
@@ -0,0 +1,178 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// MergedLogger fans out every write to a set of loggers, which may be any Logger implementation.
+type MergedLogger struct {
+	mu      sync.RWMutex
+	loggers []Logger
+}
+
+var _ Logger = (*MergedLogger)(nil)
+
+// Merge returns a logger that writes to all the given loggers, in order, e.g. to unify the loggers expected by two
+// libraries into a single stream. Nil loggers are skipped.
+func Merge(loggers ...Logger) *MergedLogger {
+	m := &MergedLogger{}
+	for _, l := range loggers {
+		m.Add(l)
+	}
+	return m
+}
+
+// Add adds l to the loggers written to, unless it is nil or already added. Loggers are usually pointers and compared
+// by identity; loggers of types that cannot be compared with ==, such as structs holding a slice, are compared by value.
+func (m *MergedLogger) Add(l Logger) {
+	if l == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !slices.ContainsFunc(m.loggers, func(o Logger) bool { return sameLogger(o, l) }) {
+		m.loggers = append(m.loggers, l)
+	}
+}
+
+// Remove removes l from the loggers written to, compared like in Add; it is not closed.
+func (m *MergedLogger) Remove(l Logger) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.loggers = slices.DeleteFunc(slices.Clone(m.loggers), func(o Logger) bool { return sameLogger(o, l) })
+}
+
+// sameLogger reports whether a and b are the same logger without panicking on uncomparable types: funcs are compared
+// by pointer and other uncomparable values with reflect.DeepEqual.
+func sameLogger(a, b Logger) bool {
+	t := reflect.TypeOf(a)
+	if t != reflect.TypeOf(b) {
+		return false
+	}
+	if t == nil || t.Comparable() {
+		return a == b
+	}
+	if t.Kind() == reflect.Func {
+		return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Loggers returns a copy of the loggers written to.
+func (m *MergedLogger) Loggers() []Logger {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return slices.Clone(m.loggers)
+}
+
+// Flush flushes every logger that has a Flush() error method and drains every logger that has a
+// Drain(context.Context) error method, such as FileLogger. The errors are combined with errors.Join.
+func (m *MergedLogger) Flush() error {
+	var errs []error
+	for _, l := range m.Loggers() {
+		switch f := l.(type) {
+		case interface{ Flush() error }:
+			errs = append(errs, f.Flush())
+		case interface{ Drain(context.Context) error }:
+			errs = append(errs, f.Drain(context.Background()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes every logger that implements io.Closer. The errors are combined with errors.Join.
+func (m *MergedLogger) Close() error {
+	var errs []error
+	for _, l := range m.Loggers() {
+		if c, ok := l.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LogFatal logs err on every logger in order. Loggers that terminate the program on fatal errors, such as
+// FileLogger, stop the fan-out, so they should be added last.
+func (m *MergedLogger) LogFatal(err error) {
+	for _, l := range m.Loggers() {
+		l.LogFatal(err)
+	}
+}
+
+func (m *MergedLogger) LogError(err error) {
+	for _, l := range m.Loggers() {
+		l.LogError(err)
+	}
+}
+
+func (m *MergedLogger) LogWarn(message string) {
+	for _, l := range m.Loggers() {
+		l.LogWarn(message)
+	}
+}
+
+func (m *MergedLogger) LogInfo(message string) {
+	for _, l := range m.Loggers() {
+		l.LogInfo(message)
+	}
+}
+
+func (m *MergedLogger) LogDebug(message string) {
+	for _, l := range m.Loggers() {
+		l.LogDebug(message)
+	}
+}
+
+func (m *MergedLogger) LogTrace(message string) {
+	for _, l := range m.Loggers() {
+		l.LogTrace(message)
+	}
+}
+
+func (m *MergedLogger) LogBytes(level LogLevel, label string, data []byte) {
+	for _, l := range m.Loggers() {
+		l.LogBytes(level, label, data)
+	}
+}
+
+func (m *MergedLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	for _, l := range m.Loggers() {
+		l.LogChange(field, from, to, extra)
+	}
+}
+
+func (m *MergedLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	for _, l := range m.Loggers() {
+		l.LogDiff(level, label, before, after)
+	}
+}
+
+func (m *MergedLogger) LogFatalf(format string, args ...interface{}) {
+	m.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (m *MergedLogger) LogErrorf(format string, args ...interface{}) {
+	m.LogError(fmt.Errorf(format, args...))
+}
+
+func (m *MergedLogger) LogWarnf(format string, args ...interface{}) {
+	m.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (m *MergedLogger) LogInfof(format string, args ...interface{}) {
+	m.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (m *MergedLogger) LogDebugf(format string, args ...interface{}) {
+	m.LogDebug(fmt.Sprintf(format, args...))
+}
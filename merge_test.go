@@ -0,0 +1,160 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// recordingLogger is a minimal Logger implementation that records its messages.
+type recordingLogger struct {
+	messages []string
+	closeErr error
+	closed   bool
+}
+
+func (r *recordingLogger) LogFatal(err error)      { r.record("FATAL", err.Error()) }
+func (r *recordingLogger) LogError(err error)      { r.record("ERROR", err.Error()) }
+func (r *recordingLogger) LogWarn(message string)  { r.record("WARNING", message) }
+func (r *recordingLogger) LogInfo(message string)  { r.record("INFO", message) }
+func (r *recordingLogger) LogDebug(message string) { r.record("DEBUG", message) }
+func (r *recordingLogger) LogTrace(message string) { r.record("TRACE", message) }
+
+func (r *recordingLogger) LogBytes(level LogLevel, label string, data []byte) {
+	r.record(level.String(), formatBytes(label, data, 0))
+}
+
+func (r *recordingLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	r.record("INFO", formatChange(field, from, to, extra))
+}
+
+func (r *recordingLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	r.record(level.String(), label)
+}
+
+func (r *recordingLogger) LogFatalf(format string, args ...interface{}) {
+	r.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (r *recordingLogger) LogErrorf(format string, args ...interface{}) {
+	r.LogError(fmt.Errorf(format, args...))
+}
+
+func (r *recordingLogger) LogWarnf(format string, args ...interface{}) {
+	r.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) LogInfof(format string, args ...interface{}) {
+	r.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) LogDebugf(format string, args ...interface{}) {
+	r.LogDebug(fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Close() error {
+	r.closed = true
+	return r.closeErr
+}
+
+func (r *recordingLogger) record(level, message string) {
+	r.messages = append(r.messages, fmt.Sprintf("%s %s", level, message))
+}
+
+func TestMerge(t *testing.T) {
+	captureConsole(t)
+	fileLogger := newTestLogger(t)
+	fieldTarget := newTestLogger(t)
+	recorder := &recordingLogger{}
+
+	merged := Merge(fileLogger, fieldTarget.WithField("lib", "b"), recorder, nil)
+
+	merged.LogInfo("info message")
+	merged.LogWarn("warn message")
+	merged.LogError(errors.New("error message"))
+	merged.LogBytes(LogLevelInfo, "payload", []byte("ab"))
+	merged.LogChange("replicas", 2, 3, nil)
+
+	expected := []string{"INFO info message", "WARNING warn message", "ERROR error message", "INFO payload", "length=2", "CHANGE replicas: 2 -> 3"}
+	for _, content := range []string{readLogFile(t, fileLogger), readLogFile(t, fieldTarget), strings.Join(recorder.messages, "\n")} {
+		for _, e := range expected {
+			if !strings.Contains(content, e) {
+				t.Errorf("expected %q; got %q", e, content)
+			}
+		}
+	}
+	if content := readLogFile(t, fieldTarget); !strings.Contains(content, "INFO info message lib=b") {
+		t.Errorf("expected the field logger to add its fields; got %q", content)
+	}
+}
+
+func TestMergeAddRemove(t *testing.T) {
+	first, second := &recordingLogger{}, &recordingLogger{}
+	merged := Merge(first)
+
+	merged.Add(second)
+	merged.Add(second)
+	merged.LogInfo("both")
+	merged.Remove(first)
+	merged.LogInfo("second only")
+
+	if len(merged.Loggers()) != 1 {
+		t.Errorf("expected 1 logger; got %d", len(merged.Loggers()))
+	}
+	if strings.Join(first.messages, "|") != "INFO both" {
+		t.Errorf("expected the first logger to stop receiving after Remove; got %v", first.messages)
+	}
+	if strings.Join(second.messages, "|") != "INFO both|INFO second only" {
+		t.Errorf("expected the second logger to be added once; got %v", second.messages)
+	}
+}
+
+// uncomparableLogger is a Logger value that panics when compared with ==.
+type uncomparableLogger struct {
+	Logger
+	tags []string
+}
+
+func TestMergeUncomparableLogger(t *testing.T) {
+	rec := &recordingLogger{}
+	l := uncomparableLogger{Logger: rec, tags: []string{"api"}}
+	other := uncomparableLogger{Logger: rec, tags: []string{"db"}}
+
+	merged := Merge(l, other)
+	merged.Add(l)
+	merged.LogInfo("both")
+	merged.Remove(l)
+	merged.LogInfo("other only")
+
+	if len(merged.Loggers()) != 1 {
+		t.Errorf("expected 1 logger; got %d", len(merged.Loggers()))
+	}
+	if strings.Join(rec.messages, "|") != "INFO both|INFO both|INFO other only" {
+		t.Errorf("expected each logger to be added once; got %v", rec.messages)
+	}
+}
+
+func TestMergeClose(t *testing.T) {
+	captureConsole(t)
+	fileLogger := newTestLogger(t)
+	failing := &recordingLogger{closeErr: errors.New("close failed")}
+	ok := &recordingLogger{}
+
+	merged := Merge(failing, fileLogger, ok)
+	if err := merged.Flush(); err != nil {
+		t.Errorf("expected Flush to succeed; got %s", err)
+	}
+	err := merged.Close()
+
+	if err == nil || !strings.Contains(err.Error(), "close failed") {
+		t.Errorf("expected the close error; got %v", err)
+	}
+	if !failing.closed || !ok.closed {
+		t.Errorf("expected every logger to be closed")
+	}
+	fileLogger.LogInfo("after close")
+	if strings.Contains(readLogFile(t, fileLogger), "after close") {
+		t.Errorf("expected the file logger to be closed")
+	}
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	logger "github.com/agusespa/flogg"
+)
+
+// RequestIDHeader is the header from which NewRequestLifecycleMiddleware takes the request ID.
+const RequestIDHeader = "X-Request-ID"
+
+// NewRequestLifecycleMiddleware logs every request as a start entry at DEBUG level,
+// `event=request_start method=<M> path=<P> request_id=<ID>`, and an end entry at INFO level,
+// `event=request_end status=<S> duration_ms=<N> request_id=<ID>`, e.g. to count requests in flight.
+// The request ID is taken from the X-Request-ID header, or generated if missing.
+//
+// Panics in the wrapped handler are recovered and logged through LogError as
+// `event=request_panic error=<V> request_id=<ID>`, and the request ends with 500 Internal Server Error unless a
+// response was already started. http.ErrAbortHandler is re-raised after the end entry, so that the server aborts the
+// response as intended.
+func NewRequestLifecycleMiddleware(l logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(RequestIDHeader)
+			if id == "" {
+				id = newRequestID()
+			}
+			start := time.Now()
+			l.LogDebug(fmt.Sprintf("event=request_start method=%s path=%s request_id=%s", r.Method, r.URL.Path, id))
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				rec := recover()
+				if rec != nil && rec != http.ErrAbortHandler {
+					l.LogError(fmt.Errorf("event=request_panic error=%s request_id=%s", strconv.Quote(fmt.Sprint(rec)), id))
+					if !sw.written {
+						http.Error(sw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					}
+					sw.status = http.StatusInternalServerError
+				}
+				l.LogInfo(fmt.Sprintf("event=request_end status=%d duration_ms=%d request_id=%s", sw.status, time.Since(start).Milliseconds(), id))
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+			}()
+
+			next.ServeHTTP(sw, r)
+		})
+	}
+}
+
+// statusWriter records the status code written to the wrapped ResponseWriter and whether the response was started.
+type statusWriter struct {
+	http.ResponseWriter
+	status  int
+	written bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.written {
+		w.status = status
+		w.written = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap returns the wrapped ResponseWriter, so that http.ResponseController reaches its optional methods.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Flush sends the buffered response to the client if the wrapped ResponseWriter is an http.Flusher.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.written = true
+		f.Flush()
+	}
+}
+
+// Hijack takes over the connection if the wrapped ResponseWriter is an http.Hijacker.
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T does not support hijacking", w.ResponseWriter)
+	}
+	w.written = true
+	return h.Hijack()
+}
+
+// newRequestID returns a random 16 byte hex encoded ID.
+func newRequestID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	logger "github.com/agusespa/flogg"
+)
+
+func TestRequestLifecycleMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		status   int
+		expected []string
+	}{
+		{
+			name: "ok",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("ok"))
+			},
+			status: http.StatusOK,
+			expected: []string{
+				`DEBUG event=request_start method=POST path=/orders request_id=req-1$`,
+				`INFO event=request_end status=200 duration_ms=\d+ request_id=req-1$`,
+			},
+		},
+		{
+			name: "not found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			status: http.StatusNotFound,
+			expected: []string{
+				`DEBUG event=request_start method=POST path=/orders request_id=req-1$`,
+				`INFO event=request_end status=404 duration_ms=\d+ request_id=req-1$`,
+			},
+		},
+		{
+			name: "panic",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				panic("nil map assignment")
+			},
+			status: http.StatusInternalServerError,
+			expected: []string{
+				`DEBUG event=request_start method=POST path=/orders request_id=req-1$`,
+				`ERROR event=request_panic error="nil map assignment" request_id=req-1$`,
+				`INFO event=request_end status=500 duration_ms=\d+ request_id=req-1$`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newTestLogger(t)
+			l.SetMinLevel(logger.LogLevelDebug)
+			server := httptest.NewServer(NewRequestLifecycleMiddleware(l)(tt.handler))
+			defer server.Close()
+
+			req, _ := http.NewRequest(http.MethodPost, server.URL+"/orders", nil)
+			req.Header.Set(RequestIDHeader, "req-1")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("expected status %d; got %d", tt.status, resp.StatusCode)
+			}
+			content := readLogFile(t, l)
+			for _, e := range tt.expected {
+				if !regexp.MustCompile(`(?m)` + e).MatchString(content) {
+					t.Errorf("expected %q; got %q", e, content)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestLifecycleMiddlewareGeneratedID(t *testing.T) {
+	l := newTestLogger(t)
+	l.SetMinLevel(logger.LogLevelDebug)
+	handler := NewRequestLifecycleMiddleware(l)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	content := readLogFile(t, l)
+	match := regexp.MustCompile(`request_start method=GET path=/ request_id=([0-9a-f]{32})`).FindStringSubmatch(content)
+	if match == nil {
+		t.Fatalf("expected a generated request ID; got %q", content)
+	}
+	if !regexp.MustCompile(`request_end status=200 duration_ms=\d+ request_id=` + match[1]).MatchString(content) {
+		t.Errorf("expected the same request ID on the end entry; got %q", content)
+	}
+}
+
+func TestRequestLifecycleMiddlewareAbortHandler(t *testing.T) {
+	l := newTestLogger(t)
+	handler := NewRequestLifecycleMiddleware(l)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("partial"))
+		panic(http.ErrAbortHandler)
+	}))
+
+	rec := httptest.NewRecorder()
+	func() {
+		defer func() {
+			if r := recover(); r != http.ErrAbortHandler {
+				t.Errorf("expected http.ErrAbortHandler to be re-raised; got %v", r)
+			}
+		}()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/stream", nil))
+	}()
+
+	if rec.Body.String() != "partial" {
+		t.Errorf("expected the partial response only; got %q", rec.Body.String())
+	}
+	content := readLogFile(t, l)
+	if strings.Contains(content, "request_panic") || !strings.Contains(content, "request_end status=200") {
+		t.Errorf("expected only the end entry for an aborted request; got %q", content)
+	}
+}
+
+func TestStatusWriterOptionalInterfaces(t *testing.T) {
+	l := newTestLogger(t)
+	handler := NewRequestLifecycleMiddleware(l)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := w.(http.Hijacker).Hijack(); err == nil {
+			t.Errorf("expected an error hijacking a ResponseRecorder")
+		}
+		w.Write([]byte("chunk"))
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("expected Flush to reach the ResponseRecorder; got %s", err)
+		}
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+	if !rec.Flushed {
+		t.Errorf("expected the response to be flushed")
+	}
+}
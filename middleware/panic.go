@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	logger "github.com/agusespa/flogg"
+)
+
+// PanicLoggingMiddleware recovers from panics in the wrapped handler, logs the panic value together with the stack trace
+// and responds with 500 Internal Server Error, unless the handler already started the response. If rethrow is true the
+// panic is re-raised after logging. http.ErrAbortHandler is re-raised without being logged, so that the server aborts
+// the response as intended.
+//
+// Panics are logged through LogError: LogFatal terminates the program, which would take the whole server down.
+func PanicLoggingMiddleware(l logger.Logger, rethrow bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+
+				l.LogError(fmt.Errorf("panic serving %s %s: %v\n%s", r.Method, r.URL.Path, rec, debug.Stack()))
+				if !sw.written {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+
+				if rethrow {
+					panic(rec)
+				}
+			}()
+
+			next.ServeHTTP(sw, r)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	logger "github.com/agusespa/flogg"
+	flogtesting "github.com/agusespa/flogg/testing"
+)
+
+func newTestLogger(t *testing.T) *logger.FileLogger {
+	output := log.Writer()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(output) })
+
+	l, err := flogtesting.NewLoggerForTest(t)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	return l
+}
+
+func readLogFile(t *testing.T, l *logger.FileLogger) string {
+	content, err := os.ReadFile(l.CurrentLogFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	return string(content)
+}
+
+func TestPanicLoggingMiddleware(t *testing.T) {
+	l := newTestLogger(t)
+	handler := PanicLoggingMiddleware(l, false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("nil map assignment")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/orders", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d; got %d", http.StatusInternalServerError, rec.Code)
+	}
+
+	content := readLogFile(t, l)
+	if !strings.Contains(content, "ERROR panic serving GET /orders: nil map assignment") {
+		t.Errorf("expected the panic value in the log; got %q", content)
+	}
+	if !strings.Contains(content, "goroutine ") || !strings.Contains(content, "panic_test.go") {
+		t.Errorf("expected a stack trace in the log; got %q", content)
+	}
+}
+
+func TestPanicLoggingMiddlewareRethrow(t *testing.T) {
+	l := newTestLogger(t)
+	handler := PanicLoggingMiddleware(l, true)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("expected the panic to be re-raised; got %v", r)
+			}
+		}()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/pay", nil))
+	}()
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d; got %d", http.StatusInternalServerError, rec.Code)
+	}
+	if content := readLogFile(t, l); !strings.Contains(content, "panic serving POST /pay: boom") {
+		t.Errorf("expected the panic to be logged before re-raising; got %q", content)
+	}
+}
+
+func TestPanicLoggingMiddlewareNoPanic(t *testing.T) {
+	l := newTestLogger(t)
+	handler := PanicLoggingMiddleware(l, false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("expected status %d; got %d", http.StatusNoContent, rec.Code)
+	}
+	if content := readLogFile(t, l); content != "" {
+		t.Errorf("expected nothing logged; got %q", content)
+	}
+}
+
+func TestPanicLoggingMiddlewareAfterWrite(t *testing.T) {
+	l := newTestLogger(t)
+	handler := PanicLoggingMiddleware(l, false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("partial"))
+		panic("lost connection")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export", nil))
+
+	if rec.Code != http.StatusAccepted || rec.Body.String() != "partial" {
+		t.Errorf("expected the started response to be kept; got %d %q", rec.Code, rec.Body.String())
+	}
+	if content := readLogFile(t, l); !strings.Contains(content, "panic serving GET /export: lost connection") {
+		t.Errorf("expected the panic to be logged; got %q", content)
+	}
+}
+
+func TestPanicLoggingMiddlewareAbortHandler(t *testing.T) {
+	l := newTestLogger(t)
+	handler := PanicLoggingMiddleware(l, false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	rec := httptest.NewRecorder()
+	func() {
+		defer func() {
+			if r := recover(); r != http.ErrAbortHandler {
+				t.Errorf("expected http.ErrAbortHandler to be re-raised; got %v", r)
+			}
+		}()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/stream", nil))
+	}()
+
+	if rec.Body.Len() != 0 {
+		t.Errorf("expected no response body; got %q", rec.Body.String())
+	}
+	if content := readLogFile(t, l); content != "" {
+		t.Errorf("expected nothing logged; got %q", content)
+	}
+}
@@ -0,0 +1,41 @@
+package logger
+
+// modeSnapshot holds the mode and minimum level restored by SwitchToProductionMode.
+type modeSnapshot struct {
+	devMode  bool
+	minLevel LogLevel
+}
+
+// SwitchToDevMode turns on development mode and lowers the minimum level to LogLevelDebug, e.g. during a live
+// debugging session; console output is colored again if a color scheme is set. SwitchToProductionMode undoes it.
+func (l *FileLogger) SwitchToDevMode() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.SetDevMode(true)
+	l.SetMinLevel(LogLevelDebug)
+}
+
+// SwitchToProductionMode restores the mode and minimum level the logger was created with.
+func (l *FileLogger) SwitchToProductionMode() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.SetDevMode(l.prodMode.devMode)
+	l.SetMinLevel(l.prodMode.minLevel)
+}
+
+// saveProductionMode records the current mode and minimum level for SwitchToProductionMode.
+func (l *FileLogger) saveProductionMode() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prodMode = modeSnapshot{devMode: l.IsDevMode(), minLevel: l.MinLevel()}
+}
+
+func (l *FileLogger) productionMode() modeSnapshot {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.prodMode
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSwitchMode(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelInfo))
+
+	logger.LogDebug("hidden before")
+	logger.SwitchToDevMode()
+	if !logger.IsDevMode() || logger.MinLevel() != LogLevelDebug {
+		t.Errorf("expected dev mode at DEBUG; got dev mode %t at %s", logger.IsDevMode(), logger.MinLevel())
+	}
+	logger.LogDebug("shown in dev mode")
+
+	logger.SwitchToProductionMode()
+	if logger.IsDevMode() || logger.MinLevel() != LogLevelInfo {
+		t.Errorf("expected production mode at INFO; got dev mode %t at %s", logger.IsDevMode(), logger.MinLevel())
+	}
+	logger.LogDebug("hidden after")
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "DEBUG shown in dev mode") {
+		t.Errorf("expected the debug message in dev mode; got %q", content)
+	}
+	if strings.Contains(content, "hidden") {
+		t.Errorf("expected no debug messages outside dev mode; got %q", content)
+	}
+}
+
+func TestSwitchModeConsole(t *testing.T) {
+	console := captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.SwitchToDevMode()
+	logger.LogDebug("dev console")
+	logger.SwitchToProductionMode()
+	logger.LogDebug("production console")
+
+	if out := console.String(); !strings.Contains(out, "dev console") || strings.Contains(out, "production console") {
+		t.Errorf("expected only the dev mode debug message on the console; got %q", out)
+	}
+}
+
+func TestSwitchModeConcurrent(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			logger.SwitchToDevMode()
+			logger.SwitchToProductionMode()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		logger.LogDebug("message")
+	}
+	<-done
+
+	if logger.IsDevMode() {
+		t.Errorf("expected production mode after the last switch")
+	}
+}
@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MultiDirLogger writes the logs of each subsystem of a process, such as api, worker or scheduler,
+// to its own log directory.
+type MultiDirLogger struct {
+	loggers map[string]*FileLogger
+}
+
+// NewMultiDirLogger creates a MultiDirLogger with one FileLogger per entry of dirs, which maps subsystem names
+// to log directories. The directories are created if needed, and minLevel and opts apply to every subsystem.
+func NewMultiDirLogger(dirs map[string]string, minLevel LogLevel, opts ...Option) (*MultiDirLogger, error) {
+	m := &MultiDirLogger{loggers: make(map[string]*FileLogger, len(dirs))}
+	opts = append([]Option{WithMinLevel(minLevel)}, opts...)
+
+	for subsystem, dir := range dirs {
+		l, err := newLogger(false, dir, opts...)
+		if err != nil {
+			m.Close()
+			return nil, fmt.Errorf("failed creating logger for %s: %w", subsystem, err)
+		}
+		m.loggers[subsystem] = l
+	}
+	return m, nil
+}
+
+// For returns the logger of subsystem, or nil if it was not in the directories given to NewMultiDirLogger.
+func (m *MultiDirLogger) For(subsystem string) Logger {
+	l, ok := m.loggers[subsystem]
+	if !ok {
+		return nil
+	}
+	return l
+}
+
+// Close closes the loggers of every subsystem.
+func (m *MultiDirLogger) Close() error {
+	var errs []error
+	for subsystem, l := range m.loggers {
+		if err := l.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed closing logger for %s: %w", subsystem, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Stats returns a snapshot of the counters of every subsystem logger, keyed by subsystem name.
+func (m *MultiDirLogger) Stats() map[string]LoggerStats {
+	stats := make(map[string]LoggerStats, len(m.loggers))
+	for subsystem, l := range m.loggers {
+		stats[subsystem] = l.Stats()
+	}
+	return stats
+}
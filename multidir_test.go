@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMultiDirLogger(t *testing.T) {
+	captureConsole(t)
+	root := t.TempDir()
+	dirs := map[string]string{
+		"api":       filepath.Join(root, "api"),
+		"worker":    filepath.Join(root, "worker"),
+		"scheduler": filepath.Join(root, "scheduler"),
+	}
+
+	m, err := NewMultiDirLogger(dirs, LogLevelInfo)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	for subsystem := range dirs {
+		m.For(subsystem).LogInfo("message from " + subsystem)
+	}
+	m.For("worker").LogDebug("below the minimum level")
+	if err := m.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	for subsystem, dir := range dirs {
+		entries := readLogDir(t, dir)
+		if len(entries) != 1 {
+			t.Fatalf("expected one log file in %s; got %d", dir, len(entries))
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+		if err != nil {
+			t.Fatalf("failed to read log file: %s", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		if len(lines) != 1 || !strings.HasSuffix(lines[0], "INFO message from "+subsystem) {
+			t.Errorf("expected only the %s entry; got %q", subsystem, lines)
+		}
+	}
+}
+
+func TestMultiDirLoggerUnknownSubsystem(t *testing.T) {
+	m, err := NewMultiDirLogger(map[string]string{"api": t.TempDir()}, LogLevelInfo)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer m.Close()
+
+	if l := m.For("billing"); l != nil {
+		t.Errorf("expected nil for an unknown subsystem; got %v", l)
+	}
+}
+
+func TestMultiDirLoggerStats(t *testing.T) {
+	m, err := NewMultiDirLogger(map[string]string{"api": t.TempDir(), "worker": t.TempDir()}, LogLevelInfo)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer m.Close()
+
+	stats := m.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("expected stats for 2 subsystems; got %d", len(stats))
+	}
+	if _, ok := stats["worker"]; !ok {
+		t.Errorf("expected stats for worker; got %v", stats)
+	}
+}
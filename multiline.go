@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+const defaultMaxMultilineLines = 100
+
+// WithMaxMultilineLines caps the number of lines written by LogMultiline; the default is 100.
+func WithMaxMultilineLines(n int) Option {
+	return func(l *FileLogger) {
+		l.MaxMultilineLines = n
+	}
+}
+
+// LogMultiline logs text such as a stack trace or a SQL query as a single block between multiline_start and
+// multiline_end markers, with every line prefixed by `  | ` to keep its indentation readable.
+// Text longer than MaxMultilineLines is cut off and followed by a note with the total number of lines.
+func (l *FileLogger) LogMultiline(level LogLevel, label string, text string) {
+	if !l.enabled(level) {
+		return
+	}
+
+	lines := formatMultiline(label, text, l.MaxMultilineLines)
+	messages := make([]string, len(lines))
+	for i, line := range lines {
+		messages[i] = fmt.Sprintf("%s %s", level, line)
+	}
+	l.logToFile(messages...)
+
+	for i, line := range lines {
+		l.logToOutputs(level, messages[i])
+		l.logToConsole(level, line)
+	}
+}
+
+func formatMultiline(label string, text string, maxLines int) []string {
+	if maxLines <= 0 {
+		maxLines = defaultMaxMultilineLines
+	}
+
+	textLines := strings.Split(strings.TrimRight(text, "\r\n"), "\n")
+	written := textLines
+	if len(written) > maxLines {
+		written = written[:maxLines]
+	}
+
+	lines := make([]string, 0, len(written)+3)
+	lines = append(lines, fmt.Sprintf("multiline_start label=%s lines=%d", label, len(textLines)))
+	for _, line := range written {
+		lines = append(lines, "  | "+strings.TrimSuffix(line, "\r"))
+	}
+	if len(textLines) > maxLines {
+		lines = append(lines, fmt.Sprintf("  | [truncated: %d lines total]", len(textLines)))
+	}
+	lines = append(lines, fmt.Sprintf("multiline_end label=%s", label))
+	return lines
+}
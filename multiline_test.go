@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestFormatMultiline(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxLines int
+		expected []string
+	}{
+		{
+			name: "indented text",
+			text: "SELECT id\n  FROM users\n WHERE active\n",
+			expected: []string{
+				"multiline_start label=query lines=3",
+				"  | SELECT id",
+				"  |   FROM users",
+				"  |  WHERE active",
+				"multiline_end label=query",
+			},
+		},
+		{
+			name: "windows line endings",
+			text: "first\r\nsecond\r\n",
+			expected: []string{
+				"multiline_start label=query lines=2",
+				"  | first",
+				"  | second",
+				"multiline_end label=query",
+			},
+		},
+		{
+			name:     "truncated",
+			text:     "one\ntwo\nthree\nfour",
+			maxLines: 2,
+			expected: []string{
+				"multiline_start label=query lines=4",
+				"  | one",
+				"  | two",
+				"  | [truncated: 4 lines total]",
+				"multiline_end label=query",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatMultiline("query", tt.text, tt.maxLines)
+			if strings.Join(actual, "\n") != strings.Join(tt.expected, "\n") {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFormatMultilineDefaultMax(t *testing.T) {
+	text := strings.Repeat("line\n", defaultMaxMultilineLines+1)
+	lines := formatMultiline("trace", text, 0)
+	if len(lines) != defaultMaxMultilineLines+3 {
+		t.Errorf("expected %d lines; got %d", defaultMaxMultilineLines+3, len(lines))
+	}
+}
+
+func TestLogMultilineAtomic(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	block := strings.Repeat("  at handler()\n", 20)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			logger.LogMultiline(LogLevelError, fmt.Sprintf("stack%d", i), block)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			logger.LogInfo(fmt.Sprintf("concurrent %d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	for i := 0; i < len(lines); i++ {
+		if !strings.Contains(lines[i], "multiline_start") {
+			continue
+		}
+		for j := 1; j <= 20; j++ {
+			if !strings.HasSuffix(lines[i+j], "ERROR   |   at handler()") {
+				t.Fatalf("expected block line %d to be part of the block; got %q", j, lines[i+j])
+			}
+		}
+		if !strings.Contains(lines[i+21], "ERROR multiline_end") {
+			t.Fatalf("expected the block to end after 20 lines; got %q", lines[i+21])
+		}
+	}
+}
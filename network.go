@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Directions of the traffic logged by LogNetworkEvent.
+const (
+	NetworkInbound  = "inbound"
+	NetworkOutbound = "outbound"
+)
+
+// WithNetworkLogMinLevel sets the level at which LogNetworkEvent writes successful events; the default is LogLevelDebug.
+func WithNetworkLogMinLevel(level LogLevel) Option {
+	return func(l *FileLogger) {
+		l.NetworkLogMinLevel = level
+	}
+}
+
+// LogNetworkEvent logs network traffic as `network` with the direction, protocol, src, dst and bytes fields,
+// followed by the extra fields. Failed events are logged at ERROR level with the error field,
+// and successful ones at NetworkLogMinLevel.
+func (l *FileLogger) LogNetworkEvent(direction, protocol, src, dst string, bytes int64, err error, fields map[string]interface{}) {
+	level := l.NetworkLogMinLevel
+	if err != nil {
+		level = LogLevelError
+	}
+	l.logAt(level, formatNetworkEvent(direction, protocol, src, dst, bytes, err, fields))
+}
+
+func formatNetworkEvent(direction, protocol, src, dst string, bytes int64, err error, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+6)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["direction"] = direction
+	fields["protocol"] = protocol
+	fields["src"] = src
+	fields["dst"] = dst
+	fields["bytes"] = bytes
+	if err != nil {
+		fields["error"] = strconv.Quote(err.Error())
+	}
+	return fmt.Sprintf("network %s", formatFields(fields))
+}
@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLogNetworkEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction string
+		protocol  string
+		err       error
+		fields    map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "inbound tcp",
+			direction: NetworkInbound,
+			protocol:  "tcp",
+			expected:  "DEBUG network bytes=1024 direction=inbound dst=10.0.0.1:443 protocol=tcp src=192.168.1.5:51234",
+		},
+		{
+			name:      "outbound udp",
+			direction: NetworkOutbound,
+			protocol:  "udp",
+			expected:  "DEBUG network bytes=1024 direction=outbound dst=10.0.0.1:443 protocol=udp src=192.168.1.5:51234",
+		},
+		{
+			name:      "outbound http with fields",
+			direction: NetworkOutbound,
+			protocol:  "http",
+			fields:    map[string]interface{}{"method": "GET"},
+			expected:  "DEBUG network bytes=1024 direction=outbound dst=10.0.0.1:443 method=GET protocol=http src=192.168.1.5:51234",
+		},
+		{
+			name:      "inbound grpc error",
+			direction: NetworkInbound,
+			protocol:  "grpc",
+			err:       errors.New("connection reset by peer"),
+			expected:  `ERROR network bytes=1024 direction=inbound dst=10.0.0.1:443 error="connection reset by peer" protocol=grpc src=192.168.1.5:51234`,
+		},
+		{
+			name:      "outbound websocket error",
+			direction: NetworkOutbound,
+			protocol:  "websocket",
+			err:       errors.New("timeout"),
+			expected:  `ERROR network bytes=1024 direction=outbound dst=10.0.0.1:443 error="timeout" protocol=websocket src=192.168.1.5:51234`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogNetworkEvent(tt.direction, tt.protocol, "192.168.1.5:51234", "10.0.0.1:443", 1024, tt.err, tt.fields)
+
+			if content := readLogFile(t, logger); !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestNetworkLogMinLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithNetworkLogMinLevel(LogLevelInfo))
+
+	logger.LogNetworkEvent(NetworkInbound, "tcp", "a", "b", 1, nil, nil)
+	if content := readLogFile(t, logger); !strings.Contains(content, "INFO network") {
+		t.Errorf("expected the event at INFO level; got %q", content)
+	}
+}
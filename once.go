@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+)
+
+var _ Logger = (*OnceLogger)(nil)
+
+// OnceLogger drops every write whose level and message were already logged through a OnceLogger of the same
+// FileLogger, e.g. for deprecation warnings that should appear once per run. Fatal errors are never dropped.
+type OnceLogger struct {
+	logger *FileLogger
+	key    string
+}
+
+// Once returns a logger that logs each message at each level only once for the lifetime of l.
+// The same message at different levels is logged once per level.
+func (l *FileLogger) Once() *OnceLogger {
+	return &OnceLogger{logger: l}
+}
+
+// OnceWith returns a logger that logs only the first write made through any OnceWith logger of l with the same key,
+// whatever its level and message.
+func (l *FileLogger) OnceWith(key string) *OnceLogger {
+	return &OnceLogger{logger: l, key: key}
+}
+
+// Reset forgets the writes seen so far by all the OnceLogger instances of the logger, e.g. between tests.
+func (o *OnceLogger) Reset() {
+	o.logger.once.Clear()
+}
+
+// first reports whether level and message, or the explicit key, are seen for the first time, recording them if so.
+func (o *OnceLogger) first(level LogLevel, message string) bool {
+	h := fnv.New64a()
+	if o.key != "" {
+		h.Write([]byte("key\x00" + o.key))
+	} else {
+		h.Write([]byte(strconv.Itoa(int(level)) + "\x00" + message))
+	}
+
+	_, seen := o.logger.once.LoadOrStore(h.Sum64(), struct{}{})
+	return !seen
+}
+
+// logAt writes message at level if it is the first write of key at level. Messages dropped by the minimum level or
+// a suppress rule are not recorded, so they are still written once they get through.
+func (o *OnceLogger) logAt(level LogLevel, key, message string) {
+	if !o.logger.enabled(level) || o.logger.suppress(level, message) {
+		return
+	}
+	if o.first(level, key) {
+		o.logger.writeAt(level, message)
+	}
+}
+
+func (o *OnceLogger) LogFatal(err error) {
+	o.logger.LogFatal(err)
+}
+
+func (o *OnceLogger) LogError(err error) {
+	o.logAt(o.logger.errorLevel(err), err.Error(), err.Error())
+}
+
+func (o *OnceLogger) LogWarn(message string) {
+	o.logAt(LogLevelWarn, message, message)
+}
+
+func (o *OnceLogger) LogInfo(message string) {
+	o.logAt(LogLevelInfo, message, message)
+}
+
+func (o *OnceLogger) LogDebug(message string) {
+	o.logAt(LogLevelDebug, message, message)
+}
+
+func (o *OnceLogger) LogTrace(message string) {
+	o.logAt(LogLevelTrace, message, message)
+}
+
+func (o *OnceLogger) LogBytes(level LogLevel, label string, data []byte) {
+	o.logAt(level, label, formatBytes(label, data, o.logger.MaxBytesLogged))
+}
+
+func (o *OnceLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	message := formatChange(field, from, to, extra)
+	o.logAt(o.logger.ChangeMinLevel, message, message)
+}
+
+func (o *OnceLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	o.logAt(level, label, formatDiff(label, before, after))
+}
+
+func (o *OnceLogger) LogFatalf(format string, args ...interface{}) {
+	o.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (o *OnceLogger) LogErrorf(format string, args ...interface{}) {
+	o.LogError(fmt.Errorf(format, args...))
+}
+
+func (o *OnceLogger) LogWarnf(format string, args ...interface{}) {
+	o.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (o *OnceLogger) LogInfof(format string, args ...interface{}) {
+	o.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (o *OnceLogger) LogDebugf(format string, args ...interface{}) {
+	o.LogDebug(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestOnce(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	for i := 0; i < 3; i++ {
+		logger.Once().LogWarn("this API is deprecated")
+		logger.Once().LogInfo("this API is deprecated")
+		logger.Once().LogError(errors.New("config file missing"))
+	}
+	logger.LogWarn("this API is deprecated")
+
+	content := readLogFile(t, logger)
+	tests := []struct {
+		entry    string
+		expected int
+	}{
+		{entry: "WARNING this API is deprecated", expected: 2},
+		{entry: "INFO this API is deprecated", expected: 1},
+		{entry: "ERROR config file missing", expected: 1},
+	}
+	for _, tt := range tests {
+		if got := strings.Count(content, tt.entry); got != tt.expected {
+			t.Errorf("expected %q %d times; got %d", tt.entry, tt.expected, got)
+		}
+	}
+}
+
+func TestOnceWith(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.OnceWith("legacy-auth").LogWarn("legacy auth used by client 1")
+	logger.OnceWith("legacy-auth").LogInfo("legacy auth used by client 2")
+	logger.OnceWith("other").LogWarn("legacy auth used by client 1")
+
+	content := readLogFile(t, logger)
+	if strings.Count(content, "legacy auth used") != 2 || strings.Contains(content, "client 2") {
+		t.Errorf("expected one entry per key; got %q", content)
+	}
+}
+
+func TestOnceReset(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	once := logger.Once()
+	once.LogInfo("starting")
+	once.Reset()
+	once.LogInfo("starting")
+	logger.Once().LogInfo("starting")
+
+	if got := strings.Count(readLogFile(t, logger), "INFO starting"); got != 2 {
+		t.Errorf("expected the message again after Reset; got it %d times", got)
+	}
+}
+
+func TestOnceFilteredNotRecorded(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelError))
+	id := logger.AddSuppressRule(FieldMatchRule("client", "1"))
+
+	logger.Once().LogWarn("legacy auth used")
+	logger.SetMinLevel(LogLevelDebug)
+	logger.OnceWith("legacy").LogWarn("legacy client=1")
+	logger.RemoveSuppressRule(id)
+	logger.Once().LogWarn("legacy auth used")
+	logger.OnceWith("legacy").LogWarn("legacy client=1")
+	logger.Once().LogWarn("legacy auth used")
+
+	content := readLogFile(t, logger)
+	if strings.Count(content, "WARNING legacy auth used") != 1 || strings.Count(content, "WARNING legacy client=1") != 1 {
+		t.Errorf("expected each message once after it passed the level and suppress rules; got %q", content)
+	}
+}
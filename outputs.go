@@ -0,0 +1,95 @@
+package logger
+
+import (
+	"io"
+	"log"
+	"sync/atomic"
+)
+
+// OutputConfig describes an additional output that receives the log entries at or above MinLevel.
+type OutputConfig struct {
+	Name     string
+	Writer   io.Writer
+	MinLevel LogLevel
+}
+
+// OutputStats holds the number of bytes and messages written to a named output.
+type OutputStats struct {
+	Bytes    uint64
+	Messages uint64
+}
+
+type namedOutput struct {
+	config   OutputConfig
+	log      *log.Logger
+	bytes    atomic.Uint64
+	messages atomic.Uint64
+}
+
+// AddNamedOutput adds an output that receives a copy of every entry at or above cfg.MinLevel, in addition to the log file.
+// Entries below the logger's own minimum level are never written. An existing output with the same name is replaced.
+func (l *FileLogger) AddNamedOutput(cfg OutputConfig) {
+	output := &namedOutput{config: cfg}
+	output.log = log.New(countingWriter{w: cfg.Writer, n: &output.bytes}, "", log.LstdFlags)
+
+	l.outputsMu.Lock()
+	defer l.outputsMu.Unlock()
+
+	for i, o := range l.outputs {
+		if o.config.Name == cfg.Name {
+			l.outputs[i] = output
+			return
+		}
+	}
+	l.outputs = append(l.outputs, output)
+}
+
+// RemoveNamedOutput removes the output with the given name, if any.
+func (l *FileLogger) RemoveNamedOutput(name string) {
+	l.outputsMu.Lock()
+	defer l.outputsMu.Unlock()
+
+	for i, o := range l.outputs {
+		if o.config.Name == name {
+			l.outputs = append(l.outputs[:i:i], l.outputs[i+1:]...)
+			return
+		}
+	}
+}
+
+// NamedOutputStats returns the bytes and messages written to each named output, keyed by name.
+func (l *FileLogger) NamedOutputStats() map[string]OutputStats {
+	l.outputsMu.RLock()
+	defer l.outputsMu.RUnlock()
+
+	stats := make(map[string]OutputStats, len(l.outputs))
+	for _, o := range l.outputs {
+		stats[o.config.Name] = OutputStats{Bytes: o.bytes.Load(), Messages: o.messages.Load()}
+	}
+	return stats
+}
+
+func (l *FileLogger) logToOutputs(level LogLevel, line string) {
+	l.outputsMu.RLock()
+	defer l.outputsMu.RUnlock()
+
+	for _, o := range l.outputs {
+		if level < o.config.MinLevel {
+			continue
+		}
+		o.log.Println(line)
+		o.messages.Add(1)
+	}
+	l.logToDB(level, line)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n *atomic.Uint64
+}
+
+func (c countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n.Add(uint64(n))
+	return n, err
+}
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNamedOutputs(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var infoOutput, warnOutput bytes.Buffer
+	logger.AddNamedOutput(OutputConfig{Name: "file", Writer: &infoOutput, MinLevel: LogLevelInfo})
+	logger.AddNamedOutput(OutputConfig{Name: "syslog", Writer: &warnOutput, MinLevel: LogLevelWarn})
+
+	logger.LogDebug("debug message")
+	logger.LogInfo("info message")
+	logger.LogWarn("warn message")
+	logger.LogError(errors.New("error message"))
+
+	tests := []struct {
+		name     string
+		output   string
+		expected []string
+	}{
+		{name: "info output", output: infoOutput.String(), expected: []string{"INFO info message", "WARNING warn message", "ERROR error message"}},
+		{name: "warn output", output: warnOutput.String(), expected: []string{"WARNING warn message", "ERROR error message"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := strings.Split(strings.TrimSpace(tt.output), "\n")
+			if len(lines) != len(tt.expected) {
+				t.Fatalf("expected %d lines; got %q", len(tt.expected), lines)
+			}
+			for i, line := range lines {
+				if !strings.HasSuffix(line, tt.expected[i]) {
+					t.Errorf("expected line %d to end with %q; got %q", i, tt.expected[i], line)
+				}
+			}
+		})
+	}
+
+	if content := readLogFile(t, logger); strings.Count(content, "\n") != 4 {
+		t.Errorf("expected the log file to keep receiving every entry; got %q", content)
+	}
+
+	stats := logger.NamedOutputStats()
+	if stats["file"].Messages != 3 || stats["file"].Bytes != uint64(infoOutput.Len()) {
+		t.Errorf("expected 3 messages and %d bytes for file; got %+v", infoOutput.Len(), stats["file"])
+	}
+	if stats["syslog"].Messages != 2 || stats["syslog"].Bytes != uint64(warnOutput.Len()) {
+		t.Errorf("expected 2 messages and %d bytes for syslog; got %+v", warnOutput.Len(), stats["syslog"])
+	}
+}
+
+func TestRemoveNamedOutput(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var output bytes.Buffer
+	logger.AddNamedOutput(OutputConfig{Name: "extra", Writer: &output})
+	logger.LogInfo("first")
+	logger.RemoveNamedOutput("extra")
+	logger.LogInfo("second")
+
+	if !strings.Contains(output.String(), "first") || strings.Contains(output.String(), "second") {
+		t.Errorf("expected only the entry written before removal; got %q", output.String())
+	}
+	if _, ok := logger.NamedOutputStats()["extra"]; ok {
+		t.Errorf("expected no stats for a removed output")
+	}
+}
@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"bufio"
+	"io"
+)
+
+// PipeReader returns a writer whose lines are logged at the given level, e.g. to capture the output of a subprocess
+// with cmd.Stdout. Lines are read by a background goroutine until the returned stop function is called or the logger
+// is closed. Stop closes the writer and returns once every line written before it has been logged.
+func (l *FileLogger) PipeReader(level LogLevel) (io.Writer, func() error) {
+	pr, pw := io.Pipe()
+	finished := make(chan struct{})
+
+	l.goBackground(func(done <-chan struct{}) {
+		defer close(finished)
+
+		go func() {
+			select {
+			case <-done:
+				pr.Close()
+			case <-finished:
+			}
+		}()
+
+		scanner := bufio.NewScanner(pr)
+		for scanner.Scan() {
+			l.logAt(level, scanner.Text())
+		}
+		pr.CloseWithError(scanner.Err())
+	})
+
+	stop := func() error {
+		err := pw.Close()
+		<-finished
+		return err
+	}
+	return pw, stop
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPipeReader(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	w, stop := logger.PipeReader(LogLevelInfo)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				fmt.Fprintf(w, "worker %d line %d\n", i, j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if err := stop(); err != nil {
+		t.Fatalf("failed to stop pipe: %s", err)
+	}
+
+	content := readLogFile(t, logger)
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 20; j++ {
+			expected := fmt.Sprintf("INFO worker %d line %d\n", i, j)
+			if !strings.Contains(content, expected) {
+				t.Errorf("expected log file to contain %q", expected)
+			}
+		}
+	}
+
+	if _, err := w.Write([]byte("after stop\n")); err == nil {
+		t.Errorf("expected an error writing after stop")
+	}
+}
+
+func TestPipeReaderClose(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	w, _ := logger.PipeReader(LogLevelInfo)
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	if _, err := w.Write([]byte("after close\n")); err == nil {
+		t.Errorf("expected an error writing after the logger was closed")
+	}
+}
@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// LogProgress logs the progress of a long-running operation at INFO level,
+// as `PROGRESS <message> current=<c> total=<t> pct=<N.N>% eta=<duration> [fields]`.
+// The ETA is estimated from the time elapsed since the first call with the same message,
+// which is forgotten once current reaches total.
+func (l *FileLogger) LogProgress(current, total int64, message string, fields map[string]interface{}) {
+	now := time.Now()
+	start, _ := l.progressStarts.LoadOrStore(message, now)
+	if current >= total {
+		l.progressStarts.Delete(message)
+	}
+
+	l.logAt(LogLevelInfo, formatProgress(current, total, message, now.Sub(start.(time.Time)), fields))
+}
+
+// ProgressLogger reports progress through a base logger at most once per report interval.
+type ProgressLogger struct {
+	base     Logger
+	interval time.Duration
+
+	mu       sync.Mutex
+	start    time.Time
+	lastEmit time.Time
+	pending  string
+}
+
+// NewProgressLogger returns a ProgressLogger that writes to base at INFO level at most once per reportInterval.
+// The ETA is estimated from the time elapsed since the first LogProgress call.
+func NewProgressLogger(base Logger, reportInterval time.Duration) *ProgressLogger {
+	return &ProgressLogger{base: base, interval: reportInterval}
+}
+
+// LogProgress logs progress like FileLogger.LogProgress. Calls within the report interval of the last written one
+// are held back, except when current reaches total; the latest of them is written by Flush.
+func (p *ProgressLogger) LogProgress(current, total int64, message string, fields map[string]interface{}) {
+	p.mu.Lock()
+	now := time.Now()
+	if p.start.IsZero() {
+		p.start = now
+	}
+	line := formatProgress(current, total, message, now.Sub(p.start), fields)
+
+	if current < total && !p.lastEmit.IsZero() && now.Sub(p.lastEmit) < p.interval {
+		p.pending = line
+		p.mu.Unlock()
+		return
+	}
+	p.lastEmit = now
+	p.pending = ""
+	p.mu.Unlock()
+
+	p.base.LogInfo(line)
+}
+
+// Flush writes the latest progress held back by the report interval, if any.
+func (p *ProgressLogger) Flush() {
+	p.mu.Lock()
+	line := p.pending
+	p.pending = ""
+	if line != "" {
+		p.lastEmit = time.Now()
+	}
+	p.mu.Unlock()
+
+	if line != "" {
+		p.base.LogInfo(line)
+	}
+}
+
+func formatProgress(current, total int64, message string, elapsed time.Duration, fields map[string]interface{}) string {
+	pct := 0.0
+	if total > 0 {
+		pct = float64(current) / float64(total) * 100
+	}
+
+	eta := "unknown"
+	if current >= total {
+		eta = "0s"
+	} else if current > 0 && elapsed > 0 {
+		remaining := time.Duration(float64(elapsed) * float64(total-current) / float64(current))
+		eta = remaining.Round(time.Second).String()
+	}
+
+	line := fmt.Sprintf("PROGRESS %s current=%d total=%d pct=%.1f%% eta=%s", message, current, total, pct, eta)
+	if len(fields) > 0 {
+		line = fmt.Sprintf("%s %s", line, formatFields(fields))
+	}
+	return line
+}
@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  int64
+		total    int64
+		elapsed  time.Duration
+		fields   map[string]interface{}
+		expected string
+	}{
+		{name: "start", current: 0, total: 200, expected: "PROGRESS import current=0 total=200 pct=0.0% eta=unknown"},
+		{name: "quarter", current: 50, total: 200, elapsed: time.Minute, expected: "PROGRESS import current=50 total=200 pct=25.0% eta=3m0s"},
+		{name: "fraction", current: 1, total: 3, elapsed: 10 * time.Second, expected: "PROGRESS import current=1 total=3 pct=33.3% eta=20s"},
+		{name: "done", current: 200, total: 200, elapsed: time.Minute, expected: "PROGRESS import current=200 total=200 pct=100.0% eta=0s"},
+		{name: "unknown total", current: 0, total: 0, expected: "PROGRESS import current=0 total=0 pct=0.0% eta=0s"},
+		{
+			name:     "fields",
+			current:  10,
+			total:    20,
+			elapsed:  time.Second,
+			fields:   map[string]interface{}{"table": "users"},
+			expected: "PROGRESS import current=10 total=20 pct=50.0% eta=1s table=users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatProgress(tt.current, tt.total, "import", tt.elapsed, tt.fields)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogProgress(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.LogProgress(0, 4, "migration", nil)
+	logger.LogProgress(4, 4, "migration", nil)
+
+	if _, ok := logger.progressStarts.Load("migration"); ok {
+		t.Errorf("expected the start time to be forgotten once done")
+	}
+	expected := "INFO PROGRESS migration current=4 total=4 pct=100.0% eta=0s\n"
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, expected) {
+		t.Errorf("expected line ending with %q; got %q", expected, content)
+	}
+}
+
+func TestProgressLogger(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	progress := NewProgressLogger(logger, time.Hour)
+
+	for i := int64(1); i <= 5; i++ {
+		progress.LogProgress(i, 10, "import", nil)
+	}
+
+	content := readLogFile(t, logger)
+	if strings.Count(content, "PROGRESS") != 1 || !strings.Contains(content, "current=1 ") {
+		t.Errorf("expected only the first call to be written; got %q", content)
+	}
+
+	progress.Flush()
+	progress.Flush()
+	content = readLogFile(t, logger)
+	if strings.Count(content, "PROGRESS") != 2 || !strings.Contains(content, "current=5 ") {
+		t.Errorf("expected flush to write the latest call once; got %q", content)
+	}
+
+	progress.LogProgress(10, 10, "import", nil)
+	if content := readLogFile(t, logger); !strings.Contains(content, "current=10 ") {
+		t.Errorf("expected completion to always be written; got %q", content)
+	}
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultMaxQueryParamLen = 64
+
+// WithSlowQueryThreshold makes LogQL log queries that take longer than d as warnings with slow_query=true.
+// Slow queries are not detected when d is 0, the default.
+func WithSlowQueryThreshold(d time.Duration) Option {
+	return func(l *FileLogger) {
+		l.SlowQueryThreshold = d
+	}
+}
+
+// WithLogQueryParams makes LogQL include the query parameters, which are left out by default since they may hold user data.
+func WithLogQueryParams(enabled bool) Option {
+	return func(l *FileLogger) {
+		l.LogQueryParams = enabled
+	}
+}
+
+// WithMaxQueryParamLen cuts off every query parameter logged by LogQL after n characters; the default is 64.
+func WithMaxQueryParamLen(n int) Option {
+	return func(l *FileLogger) {
+		l.MaxQueryParamLen = n
+	}
+}
+
+// LogQL logs a database query with the query, duration_ms and rows fields, and the params field if LogQueryParams is set.
+// Failed queries are logged at ERROR level with the error field, queries slower than SlowQueryThreshold at WARNING
+// level with slow_query=true, and all others at DEBUG level.
+func (l *FileLogger) LogQL(query string, params []interface{}, duration time.Duration, rows int, err error) {
+	level := LogLevelDebug
+	fields := map[string]interface{}{
+		"query":       strconv.Quote(query),
+		"duration_ms": duration.Milliseconds(),
+		"rows":        rows,
+	}
+	switch {
+	case err != nil:
+		level = LogLevelError
+		fields["error"] = strconv.Quote(err.Error())
+	case l.SlowQueryThreshold > 0 && duration > l.SlowQueryThreshold:
+		level = LogLevelWarn
+		fields["slow_query"] = true
+	}
+	if !l.enabled(level) {
+		return
+	}
+
+	if l.LogQueryParams {
+		fields["params"] = formatQueryParams(params, l.MaxQueryParamLen)
+	}
+	l.logAt(level, fmt.Sprintf("db_query %s", formatFields(fields)))
+}
+
+// formatQueryParams renders params as a list of quoted values, each cut off after maxLen characters.
+func formatQueryParams(params []interface{}, maxLen int) string {
+	if maxLen <= 0 {
+		maxLen = defaultMaxQueryParamLen
+	}
+
+	quoted := make([]string, len(params))
+	for i, param := range params {
+		value := []rune(fmt.Sprint(param))
+		if len(value) > maxLen {
+			value = append(value[:maxLen], []rune("...")...)
+		}
+		quoted[i] = strconv.Quote(string(value))
+	}
+	return "[" + strings.Join(quoted, ",") + "]"
+}
@@ -0,0 +1,116 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		params   []interface{}
+		duration time.Duration
+		rows     int
+		err      error
+		expected string
+	}{
+		{
+			name:     "fast query",
+			opts:     []Option{WithSlowQueryThreshold(time.Second)},
+			duration: 12 * time.Millisecond,
+			rows:     3,
+			expected: `DEBUG db_query duration_ms=12 query="SELECT * FROM users WHERE id = $1" rows=3`,
+		},
+		{
+			name:     "slow query",
+			opts:     []Option{WithSlowQueryThreshold(time.Second)},
+			duration: 1500 * time.Millisecond,
+			rows:     1,
+			expected: `WARNING db_query duration_ms=1500 query="SELECT * FROM users WHERE id = $1" rows=1 slow_query=true`,
+		},
+		{
+			name:     "no slow threshold",
+			duration: time.Hour,
+			expected: `DEBUG db_query duration_ms=3600000 query="SELECT * FROM users WHERE id = $1" rows=0`,
+		},
+		{
+			name:     "failed query",
+			opts:     []Option{WithSlowQueryThreshold(time.Second)},
+			duration: 2 * time.Second,
+			err:      errors.New("connection reset"),
+			expected: `ERROR db_query duration_ms=2000 error="connection reset" query="SELECT * FROM users WHERE id = $1" rows=0`,
+		},
+		{
+			name:     "params omitted by default",
+			params:   []interface{}{42},
+			expected: `DEBUG db_query duration_ms=0 query="SELECT * FROM users WHERE id = $1" rows=0`,
+		},
+		{
+			name:     "params",
+			opts:     []Option{WithLogQueryParams(true)},
+			params:   []interface{}{42, "alice smith"},
+			expected: `DEBUG db_query duration_ms=0 params=["42","alice smith"] query="SELECT * FROM users WHERE id = $1" rows=0`,
+		},
+		{
+			name:     "truncated params",
+			opts:     []Option{WithLogQueryParams(true), WithMaxQueryParamLen(5)},
+			params:   []interface{}{"abcdefgh", "abc"},
+			expected: `DEBUG db_query duration_ms=0 params=["abcde...","abc"] query="SELECT * FROM users WHERE id = $1" rows=0`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t, tt.opts...)
+
+			logger.LogQL("SELECT * FROM users WHERE id = $1", tt.params, tt.duration, tt.rows, tt.err)
+
+			content := strings.TrimSpace(readLogFile(t, logger))
+			if !strings.HasSuffix(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestLogQLMinLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelWarn), WithSlowQueryThreshold(time.Second))
+
+	logger.LogQL("SELECT 1", nil, time.Millisecond, 1, nil)
+	if content := readLogFile(t, logger); content != "" {
+		t.Errorf("expected fast queries below the minimum level to be skipped; got %q", content)
+	}
+
+	logger.LogQL("SELECT 1", nil, 2*time.Second, 1, nil)
+	if content := readLogFile(t, logger); !strings.Contains(content, "slow_query=true") {
+		t.Errorf("expected the slow query to be logged; got %q", content)
+	}
+}
+
+func TestFormatQueryParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   []interface{}
+		maxLen   int
+		expected string
+	}{
+		{name: "no params", expected: "[]"},
+		{name: "default length", params: []interface{}{strings.Repeat("x", 70)}, expected: `["` + strings.Repeat("x", 64) + `..."]`},
+		{name: "multibyte", params: []interface{}{"héllo"}, maxLen: 2, expected: `["hé..."]`},
+		{name: "quotes", params: []interface{}{`say "hi"`}, maxLen: 20, expected: `["say \"hi\""]`},
+		{name: "nil", params: []interface{}{nil}, maxLen: 20, expected: `["<nil>"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatQueryParams(tt.params, tt.maxLen); got != tt.expected {
+				t.Errorf("expected %s; got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+)
+
+// WithRateLimitMinLevel sets the level at which LogRateLimitEvent writes allowed requests; the default is LogLevelDebug.
+func WithRateLimitMinLevel(level LogLevel) Option {
+	return func(l *FileLogger) {
+		l.RateLimitMinLevel = level
+	}
+}
+
+// LogRateLimitEvent logs a rate limiting decision for resource as
+// `RATELIMIT <resource> allowed=<bool> remaining=<remaining>/<limit> resets_in=<duration> window_remaining_ms=<ms>`,
+// followed by the extra fields. Blocked requests are logged at WARNING level and allowed ones at RateLimitMinLevel.
+func (l *FileLogger) LogRateLimitEvent(resource string, allowed bool, limit, remaining int, resetAt time.Time, fields map[string]interface{}) {
+	level := l.RateLimitMinLevel
+	if !allowed {
+		level = LogLevelWarn
+	}
+	if !l.enabled(level) {
+		return
+	}
+	l.logAt(level, formatRateLimitEvent(resource, allowed, limit, remaining, time.Until(resetAt), fields))
+}
+
+func formatRateLimitEvent(resource string, allowed bool, limit, remaining int, resetsIn time.Duration, fields map[string]interface{}) string {
+	if resetsIn < 0 {
+		resetsIn = 0
+	}
+	message := fmt.Sprintf("RATELIMIT %s allowed=%t remaining=%d/%d resets_in=%s window_remaining_ms=%d",
+		resource, allowed, remaining, limit, resetsIn.Round(time.Second), resetsIn.Milliseconds())
+	if len(fields) > 0 {
+		message = fmt.Sprintf("%s %s", message, formatFields(fields))
+	}
+	return message
+}
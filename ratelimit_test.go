@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestLogRateLimitEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowed   bool
+		remaining int
+		resetIn   time.Duration
+		fields    map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "allowed",
+			allowed:   true,
+			remaining: 42,
+			resetIn:   30*time.Second + 200*time.Millisecond,
+			expected:  `DEBUG RATELIMIT /api/orders allowed=true remaining=42/100 resets_in=30s window_remaining_ms=30\d{3}$`,
+		},
+		{
+			name:     "blocked",
+			resetIn:  time.Minute + 200*time.Millisecond,
+			fields:   map[string]interface{}{"client": "10.0.0.7"},
+			expected: `WARNING RATELIMIT /api/orders allowed=false remaining=0/100 resets_in=1m0s window_remaining_ms=60\d{3} client=10.0.0.7$`,
+		},
+		{
+			name:     "reset in the past",
+			resetIn:  -time.Second,
+			expected: `WARNING RATELIMIT /api/orders allowed=false remaining=0/100 resets_in=0s window_remaining_ms=0$`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogRateLimitEvent("/api/orders", tt.allowed, 100, tt.remaining, time.Now().Add(tt.resetIn), tt.fields)
+
+			content := readLogFile(t, logger)
+			if !regexp.MustCompile(`(?m)` + tt.expected).MatchString(content) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestRateLimitMinLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithRateLimitMinLevel(LogLevelInfo))
+
+	logger.LogRateLimitEvent("login", true, 5, 4, time.Now().Add(time.Minute), nil)
+	if content := readLogFile(t, logger); !regexp.MustCompile(`INFO RATELIMIT login allowed=true`).MatchString(content) {
+		t.Errorf("expected the allowed event at INFO level; got %q", content)
+	}
+}
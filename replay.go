@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BatchReplay writes historical entries to the current log file, e.g. to import logs from a backup, in one go:
+// rotation is checked once before the first entry and no other writes are interleaved. The entries are written
+// with their level, message and the tags of the logger only, not to the console or the named outputs. If preserveTimestamps is true each
+// entry is written with its Time instead of the current time. All entries are validated before any is written.
+func (l *FileLogger) BatchReplay(entries []LogEntry, preserveTimestamps bool) error {
+	for i, entry := range entries {
+		if err := validateReplayEntry(entry, preserveTimestamps); err != nil {
+			return fmt.Errorf("invalid entry %d: %w", i, err)
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return errors.New("logger is closed")
+	}
+	if l.dirLock != nil {
+		l.dirLock.mu.Lock()
+		defer l.dirLock.mu.Unlock()
+	}
+	if err := l.refreshLogFile(); err != nil {
+		return fmt.Errorf("failed refreshing log file: %w", err)
+	}
+
+	w := l.FileLog.Writer()
+	prefix := l.FileLog.Prefix()
+	tags := l.Tags()
+	for _, entry := range entries {
+		line := formatTags(fmt.Sprintf("%s %s", entry.Level, entry.Message), tags)
+		if !preserveTimestamps {
+			if err := l.FileLog.Output(2, line); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s %s%s\n", entry.Time.Format(logTimeLayout), prefix, line); err != nil {
+			return err
+		}
+	}
+	l.linesWritten.Add(int64(len(entries)))
+	l.lastWrite.Store(time.Now().UnixNano())
+	return nil
+}
+
+func validateReplayEntry(entry LogEntry, preserveTimestamps bool) error {
+	if entry.Level < LogLevelTrace || entry.Level > LogLevelEmergency {
+		return fmt.Errorf("unknown level %d", entry.Level)
+	}
+	if preserveTimestamps && entry.Time.IsZero() {
+		return errors.New("missing time")
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBatchReplay(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithPrefix("[restore] "))
+	logger.LogInfo("before replay")
+
+	entries := []LogEntry{
+		{Time: time.Date(2024, 3, 1, 9, 30, 0, 0, time.Local), Level: LogLevelInfo, Message: "server started"},
+		{Time: time.Date(2024, 3, 1, 9, 31, 5, 0, time.Local), Level: LogLevelError, Message: "connection refused"},
+	}
+	if err := logger.BatchReplay(entries, true); err != nil {
+		t.Fatalf("failed to replay entries: %s", err)
+	}
+
+	content := readLogFile(t, logger)
+	for _, expected := range []string{
+		"2024/03/01 09:30:00 [restore] INFO server started\n",
+		"2024/03/01 09:31:05 [restore] ERROR connection refused\n",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q; got %q", expected, content)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	for i, entry := range entries {
+		parsed, ok := parseLogLine(lines[i+1], logger.linePrefixLocked())
+		if !ok || !parsed.Time.Equal(entry.Time) || parsed.Level != entry.Level || parsed.Message != entry.Message {
+			t.Errorf("expected the replayed entry to parse back as %+v; got %+v", entry, parsed)
+		}
+	}
+}
+
+func TestBatchReplayCurrentTime(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	entries := []LogEntry{{Level: LogLevelWarn, Message: "disk almost full"}}
+	if err := logger.BatchReplay(entries, false); err != nil {
+		t.Fatalf("failed to replay entries: %s", err)
+	}
+
+	content := readLogFile(t, logger)
+	if !strings.HasPrefix(content, time.Now().Format("2006/01/02")) || !strings.Contains(content, "WARNING disk almost full") {
+		t.Errorf("expected the entry with the current time; got %q", content)
+	}
+}
+
+func TestBatchReplayValidation(t *testing.T) {
+	tests := []struct {
+		name               string
+		entries            []LogEntry
+		preserveTimestamps bool
+	}{
+		{
+			name:               "zero time",
+			entries:            []LogEntry{{Time: time.Now(), Level: LogLevelInfo, Message: "valid"}, {Level: LogLevelInfo, Message: "no time"}},
+			preserveTimestamps: true,
+		},
+		{
+			name:    "unknown level",
+			entries: []LogEntry{{Time: time.Now(), Level: LogLevel(42), Message: "bad level"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			if err := logger.BatchReplay(tt.entries, tt.preserveTimestamps); err == nil {
+				t.Errorf("expected a validation error")
+			}
+			if content := readLogFile(t, logger); content != "" {
+				t.Errorf("expected nothing to be written; got %q", content)
+			}
+		})
+	}
+}
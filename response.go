@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// WithErrorResponseTemplate sets the function that builds the response body written by LogResponseError.
+// The default body is JSON of the form {"error":"...","status":N}. The body of the template is sent with the
+// Content-Type already set on the ResponseWriter, or application/json if there is none.
+func WithErrorResponseTemplate(fn func(err error, code int) []byte) Option {
+	return func(l *FileLogger) {
+		l.ErrorResponseTemplate = fn
+	}
+}
+
+// LogResponseError logs a failed HTTP request at ERROR level and writes the error response with statusCode.
+// The entry has the response_error label and the method, path, status and error fields in addition to fields.
+// Like LogError, ErrorLevelRules can log err at another level.
+func (l *FileLogger) LogResponseError(w http.ResponseWriter, r *http.Request, err error, statusCode int, fields map[string]interface{}) {
+	message := errorResponseMessage(err, statusCode)
+
+	var body []byte
+	var contentType string
+	if l.ErrorResponseTemplate != nil {
+		body = l.ErrorResponseTemplate(err, statusCode)
+	} else {
+		body, _ = json.Marshal(struct {
+			Error  string `json:"error"`
+			Status int    `json:"status"`
+		}{Error: message, Status: statusCode})
+		contentType = "application/json"
+	}
+
+	extra := map[string]interface{}{"method": r.Method, "path": r.URL.Path}
+	l.respondError(w, "response_error", statusCode, err, message, fields, extra, body, contentType)
+}
+
+// errorResponseMessage returns the text of err, or the status text of statusCode if err is nil.
+func errorResponseMessage(err error, statusCode int) string {
+	if err == nil {
+		return http.StatusText(statusCode)
+	}
+	return err.Error()
+}
+
+// respondError logs label with fields, extra, the status and the quoted error message, at the level ErrorLevelRules
+// give err as in LogError, and writes body as the response with statusCode. An empty contentType keeps the
+// Content-Type already set on w, or sets application/json if there is none.
+func (l *FileLogger) respondError(w http.ResponseWriter, label string, statusCode int, err error, message string,
+	fields, extra map[string]interface{}, body []byte, contentType string) {
+	merged := make(map[string]interface{}, len(fields)+len(extra)+2)
+	for k, v := range fields {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	merged["status"] = statusCode
+	merged["error"] = strconv.Quote(message)
+
+	level := LogLevelError
+	if err != nil {
+		level = l.errorLevel(err)
+	}
+	l.logAt(level, fmt.Sprintf("%s %s", label, formatFields(merged)))
+
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	} else if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogResponseError(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		err          error
+		statusCode   int
+		fields       map[string]interface{}
+		contentType  string
+		expectedLog  string
+		expectedBody string
+		expectedType string
+	}{
+		{
+			name:         "default body",
+			err:          errors.New("user not found"),
+			statusCode:   http.StatusNotFound,
+			expectedLog:  "ERROR response_error error=\"user not found\" method=GET path=/users/7 status=404\n",
+			expectedBody: `{"error":"user not found","status":404}`,
+			expectedType: "application/json",
+		},
+		{
+			name:         "extra fields",
+			err:          errors.New("db timeout"),
+			statusCode:   http.StatusInternalServerError,
+			fields:       map[string]interface{}{"request_id": "abc"},
+			expectedLog:  "ERROR response_error error=\"db timeout\" method=GET path=/users/7 request_id=abc status=500\n",
+			expectedBody: `{"error":"db timeout","status":500}`,
+			expectedType: "application/json",
+		},
+		{
+			name:         "nil error",
+			statusCode:   http.StatusTooManyRequests,
+			expectedLog:  "ERROR response_error error=\"Too Many Requests\" method=GET path=/users/7 status=429\n",
+			expectedBody: `{"error":"Too Many Requests","status":429}`,
+			expectedType: "application/json",
+		},
+		{
+			name: "custom template",
+			opts: []Option{WithErrorResponseTemplate(func(err error, code int) []byte {
+				return []byte(fmt.Sprintf("%d: %s", code, err))
+			})},
+			err:          errors.New("invalid id"),
+			statusCode:   http.StatusBadRequest,
+			expectedLog:  "ERROR response_error error=\"invalid id\" method=GET path=/users/7 status=400\n",
+			expectedBody: "400: invalid id",
+			expectedType: "application/json",
+		},
+		{
+			name: "custom template with content type",
+			opts: []Option{WithErrorResponseTemplate(func(err error, code int) []byte {
+				return []byte(err.Error())
+			})},
+			err:          errors.New("invalid id"),
+			statusCode:   http.StatusBadRequest,
+			contentType:  "text/plain",
+			expectedLog:  "ERROR response_error error=\"invalid id\" method=GET path=/users/7 status=400\n",
+			expectedBody: "invalid id",
+			expectedType: "text/plain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, tt.opts...)
+			rec := httptest.NewRecorder()
+			if tt.contentType != "" {
+				rec.Header().Set("Content-Type", tt.contentType)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/users/7?full=1", nil)
+
+			logger.LogResponseError(rec, req, tt.err, tt.statusCode, tt.fields)
+
+			if rec.Code != tt.statusCode {
+				t.Errorf("expected status %d; got %d", tt.statusCode, rec.Code)
+			}
+			if rec.Body.String() != tt.expectedBody {
+				t.Errorf("expected body %q; got %q", tt.expectedBody, rec.Body.String())
+			}
+			if tt.expectedType != "" && rec.Header().Get("Content-Type") != tt.expectedType {
+				t.Errorf("expected content type %q; got %q", tt.expectedType, rec.Header().Get("Content-Type"))
+			}
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expectedLog) {
+				t.Errorf("expected line ending with %q; got %q", tt.expectedLog, content)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// LogRetry logs a failed attempt of a retry loop with the attempt, max_attempts, retry_in and error fields.
+// Intermediate attempts are logged at WARN level and the final one, when attempt equals maxAttempts, at ERROR level.
+func (l *FileLogger) LogRetry(attempt, maxAttempts int, err error, nextRetryIn time.Duration, fields map[string]interface{}) {
+	l.logAt(retryLevel(attempt, maxAttempts), formatRetry(attempt, maxAttempts, err, nextRetryIn, fields))
+}
+
+// RetryStats holds the number of retries and final failures logged through a RetryLogger.
+type RetryStats struct {
+	Retries  uint64
+	Failures uint64
+}
+
+// RetryLogger wraps a Logger to log retry attempts and count them.
+type RetryLogger struct {
+	Logger
+	retries  atomic.Uint64
+	failures atomic.Uint64
+}
+
+// NewRetryLogger returns a RetryLogger that writes through base.
+func NewRetryLogger(base Logger) *RetryLogger {
+	return &RetryLogger{Logger: base}
+}
+
+// LogRetry logs a failed attempt like FileLogger.LogRetry and counts it as a retry, or as a failure on the final attempt.
+func (r *RetryLogger) LogRetry(attempt, maxAttempts int, err error, nextRetryIn time.Duration, fields map[string]interface{}) {
+	message := formatRetry(attempt, maxAttempts, err, nextRetryIn, fields)
+	if retryLevel(attempt, maxAttempts) == LogLevelError {
+		r.failures.Add(1)
+		r.Logger.LogError(errors.New(message))
+		return
+	}
+
+	r.retries.Add(1)
+	r.Logger.LogWarn(message)
+}
+
+// RetryStats returns the number of retries and failures logged so far.
+func (r *RetryLogger) RetryStats() RetryStats {
+	return RetryStats{Retries: r.retries.Load(), Failures: r.failures.Load()}
+}
+
+func retryLevel(attempt, maxAttempts int) LogLevel {
+	if attempt >= maxAttempts {
+		return LogLevelError
+	}
+	return LogLevelWarn
+}
+
+func formatRetry(attempt, maxAttempts int, err error, nextRetryIn time.Duration, fields map[string]interface{}) string {
+	merged := make(map[string]interface{}, len(fields)+4)
+	for k, v := range fields {
+		merged[k] = v
+	}
+	merged["attempt"] = attempt
+	merged["max_attempts"] = maxAttempts
+	merged["retry_in"] = nextRetryIn
+	merged["error"] = err
+	return fmt.Sprintf("retry %s", formatFields(merged))
+}
@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempt  int
+		fields   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "intermediate attempt",
+			attempt:  1,
+			expected: "retry attempt=1 error=connection refused max_attempts=3 retry_in=2s",
+		},
+		{
+			name:     "extra fields",
+			attempt:  2,
+			fields:   map[string]interface{}{"host": "db1"},
+			expected: "retry attempt=2 error=connection refused host=db1 max_attempts=3 retry_in=2s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatRetry(tt.attempt, 3, errors.New("connection refused"), 2*time.Second, tt.fields)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+// retryOperation calls fn up to maxAttempts times, logging each failed attempt through logRetry.
+func retryOperation(maxAttempts int, fn func() error, logRetry func(attempt, maxAttempts int, err error, nextRetryIn time.Duration, fields map[string]interface{})) error {
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		logRetry(attempt, maxAttempts, err, time.Duration(attempt)*time.Second, map[string]interface{}{"op": "sync"})
+	}
+	return err
+}
+
+func TestLogRetry(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	err := retryOperation(3, func() error { return errors.New("timeout") }, logger.LogRetry)
+	if err == nil {
+		t.Fatalf("expected the operation to fail")
+	}
+
+	expected := []string{
+		"WARNING retry attempt=1 error=timeout max_attempts=3 op=sync retry_in=1s",
+		"WARNING retry attempt=2 error=timeout max_attempts=3 op=sync retry_in=2s",
+		"ERROR retry attempt=3 error=timeout max_attempts=3 op=sync retry_in=3s",
+	}
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines; got %q", len(expected), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("expected line %d to end with %q; got %q", i, expected[i], line)
+		}
+	}
+}
+
+func TestRetryLogger(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	retryLogger := NewRetryLogger(logger)
+
+	calls := 0
+	succeedOnThird := func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("timeout")
+		}
+		return nil
+	}
+	if err := retryOperation(3, succeedOnThird, retryLogger.LogRetry); err != nil {
+		t.Fatalf("expected the operation to succeed; got %s", err)
+	}
+	if err := retryOperation(3, func() error { return errors.New("timeout") }, retryLogger.LogRetry); err == nil {
+		t.Fatalf("expected the operation to fail")
+	}
+
+	expected := RetryStats{Retries: 4, Failures: 1}
+	if stats := retryLogger.RetryStats(); stats != expected {
+		t.Errorf("expected %+v; got %+v", expected, stats)
+	}
+	if content := readLogFile(t, logger); strings.Count(content, "WARNING retry") != 4 || strings.Count(content, "ERROR retry") != 1 {
+		t.Errorf("expected 4 warnings and 1 error; got %q", content)
+	}
+}
@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// ringBuffer keeps the last len(buf) bytes written to it.
+type ringBuffer struct {
+	mu     sync.Mutex
+	buf    []byte
+	start  int
+	length int
+}
+
+// WithRingBuffer keeps the last size bytes written to the log file in memory, to be read with Buffer,
+// e.g. to serve the tail of the log from a diagnostic endpoint without reading the file.
+// A size of 0 or less disables the ring buffer.
+func WithRingBuffer(size int) Option {
+	return func(l *FileLogger) {
+		if size <= 0 {
+			l.ring = nil
+			return
+		}
+		l.ring = &ringBuffer{buf: make([]byte, size)}
+	}
+}
+
+// Buffer returns a reader over the bytes currently held by the ring buffer, oldest first.
+// It is empty unless the logger was created with WithRingBuffer.
+func (l *FileLogger) Buffer() io.Reader {
+	if l.ring == nil {
+		return bytes.NewReader(nil)
+	}
+	return bytes.NewReader(l.ring.Bytes())
+}
+
+// BufferSize returns the capacity of the ring buffer in bytes, or 0 if there is none.
+func (l *FileLogger) BufferSize() int {
+	if l.ring == nil {
+		return 0
+	}
+	return len(l.ring.buf)
+}
+
+func (r *ringBuffer) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	size := len(r.buf)
+	if size == 0 {
+		return len(p), nil
+	}
+
+	if len(p) >= size {
+		copy(r.buf, p[len(p)-size:])
+		r.start, r.length = 0, size
+		return len(p), nil
+	}
+
+	end := (r.start + r.length) % size
+	n := copy(r.buf[end:], p)
+	copy(r.buf, p[n:])
+
+	r.length += len(p)
+	if r.length > size {
+		r.start = (r.start + r.length - size) % size
+		r.length = size
+	}
+	return len(p), nil
+}
+
+// Bytes returns a copy of the buffered bytes, oldest first.
+func (r *ringBuffer) Bytes() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]byte, r.length)
+	n := copy(out, r.buf[r.start:min(r.start+r.length, len(r.buf))])
+	copy(out[n:], r.buf)
+	return out
+}
@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRingBuffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		writes   []string
+		expected string
+	}{
+		{name: "below capacity", size: 10, writes: []string{"abc", "def"}, expected: "abcdef"},
+		{name: "exactly full", size: 6, writes: []string{"abc", "def"}, expected: "abcdef"},
+		{name: "wraps around", size: 5, writes: []string{"abc", "def", "gh"}, expected: "defgh"},
+		{name: "write larger than capacity", size: 4, writes: []string{"ab", "cdefghij"}, expected: "ghij"},
+		{name: "many small writes", size: 3, writes: []string{"a", "b", "c", "d", "e"}, expected: "cde"},
+		{name: "zero capacity", size: 0, writes: []string{"abc"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ringBuffer{buf: make([]byte, tt.size)}
+			for _, w := range tt.writes {
+				if n, err := r.Write([]byte(w)); n != len(w) || err != nil {
+					t.Fatalf("expected to write %d bytes; got %d, %v", len(w), n, err)
+				}
+			}
+
+			if actual := string(r.Bytes()); actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLoggerBuffer(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithRingBuffer(64))
+
+	for i := 0; i < 20; i++ {
+		logger.LogInfo(strings.Repeat("x", 10))
+	}
+	logger.LogInfo("last line")
+
+	content, err := io.ReadAll(logger.Buffer())
+	if err != nil {
+		t.Fatalf("failed to read buffer: %s", err)
+	}
+	if len(content) != 64 || logger.BufferSize() != 64 {
+		t.Errorf("expected 64 buffered bytes; got %d of %d", len(content), logger.BufferSize())
+	}
+	if !strings.HasSuffix(string(content), "INFO last line\n") {
+		t.Errorf("expected the buffer to end with the last line; got %q", content)
+	}
+	if file := readLogFile(t, logger); !strings.HasSuffix(file, string(content)) {
+		t.Errorf("expected the buffer to match the end of the log file")
+	}
+}
+
+func TestLoggerBufferDisabled(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "without ring buffer"},
+		{name: "zero size", opts: []Option{WithRingBuffer(0)}},
+		{name: "negative size", opts: []Option{WithRingBuffer(-1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t, tt.opts...)
+			logger.LogDebug("not buffered")
+
+			content, _ := io.ReadAll(logger.Buffer())
+			if len(content) != 0 || logger.BufferSize() != 0 {
+				t.Errorf("expected an empty buffer; got %q", content)
+			}
+		})
+	}
+}
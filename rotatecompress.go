@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"time"
+)
+
+// pendingCompression is a rotated log file waiting to be compressed.
+type pendingCompression struct {
+	path string
+	due  time.Time
+}
+
+// WithCompressRotated compresses every log file once the logger rotates away from it, into <name>.gz,
+// reporting the results on the channel returned by CompressEvents.
+func WithCompressRotated(enabled bool) Option {
+	return func(l *FileLogger) {
+		l.CompressRotated = enabled
+	}
+}
+
+// WithCompressAfter defers the compression of rotated log files until d has elapsed since the rotation, e.g. to give
+// a log shipper time to read them. Rotated files are compressed right away when d is 0, the default.
+// It only applies together with WithCompressRotated.
+func WithCompressAfter(d time.Duration) Option {
+	return func(l *FileLogger) {
+		l.CompressAfter = d
+	}
+}
+
+// PendingCompressions returns the paths of the rotated log files waiting to be compressed, oldest first.
+// Files still pending when the logger is closed are left uncompressed.
+func (l *FileLogger) PendingCompressions() []string {
+	l.compressQueueMu.Lock()
+	defer l.compressQueueMu.Unlock()
+
+	paths := make([]string, len(l.compressQueue))
+	for i, p := range l.compressQueue {
+		paths[i] = p.path
+	}
+	return paths
+}
+
+// startCompressWorker starts the goroutine compressing the rotated log files queued by queueCompression.
+func (l *FileLogger) startCompressWorker() {
+	wake := make(chan struct{}, 1)
+	l.compressQueueMu.Lock()
+	l.compressWake = wake
+	l.compressQueueMu.Unlock()
+
+	l.goBackground(func(done <-chan struct{}) {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-wake:
+			case <-timer.C:
+			}
+
+			next, ok := l.compressDue(done)
+			if !ok {
+				return
+			}
+			if next > 0 {
+				timer.Reset(next)
+			}
+		}
+	})
+}
+
+// queueCompression adds the rotated log file at path to the compression queue. It is called with l.mu held,
+// so the compression itself is left to the worker.
+func (l *FileLogger) queueCompression(path string) {
+	l.compressQueueMu.Lock()
+	defer l.compressQueueMu.Unlock()
+
+	if l.compressWake == nil {
+		return
+	}
+	l.compressQueue = append(l.compressQueue, pendingCompression{path: path, due: time.Now().Add(l.CompressAfter)})
+	select {
+	case l.compressWake <- struct{}{}:
+	default:
+	}
+}
+
+// compressDue compresses the queued files that are due and returns the time until the next one is, or 0 if the
+// queue is empty. It reports false if the logger was closed while sending an event.
+func (l *FileLogger) compressDue(done <-chan struct{}) (time.Duration, bool) {
+	for {
+		l.compressQueueMu.Lock()
+		if len(l.compressQueue) == 0 {
+			l.compressQueueMu.Unlock()
+			return 0, true
+		}
+		next := l.compressQueue[0]
+		if wait := time.Until(next.due); wait > 0 {
+			l.compressQueueMu.Unlock()
+			return wait, true
+		}
+		l.compressQueueMu.Unlock()
+
+		event := compressFile(next.path, next.path+".gz")
+
+		l.compressQueueMu.Lock()
+		l.compressQueue = l.compressQueue[1:]
+		l.compressQueueMu.Unlock()
+
+		l.mu.Lock()
+		events := l.compressEvents
+		l.mu.Unlock()
+		if events != nil {
+			select {
+			case events <- event:
+			case <-done:
+				return 0, false
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompressAfter(t *testing.T) {
+	captureConsole(t)
+	const delay = 100 * time.Millisecond
+	logger := newTestLogger(t, WithMaxLinesPerFile(2), WithCompressRotated(true), WithCompressAfter(delay))
+	events := logger.CompressEvents()
+	rotated := logger.CurrentLogFile.Name()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		logger.LogInfo("line")
+	}
+
+	if pending := logger.PendingCompressions(); !slices.Equal(pending, []string{rotated}) {
+		t.Fatalf("expected %s to be pending; got %v", rotated, pending)
+	}
+	if _, err := os.Stat(rotated + ".gz"); !os.IsNotExist(err) {
+		t.Errorf("expected no compressed file before CompressAfter; got %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if elapsed := time.Since(start); elapsed < delay {
+			t.Errorf("expected compression after %s; got %s", delay, elapsed)
+		}
+		if event.Err != nil || event.Path != rotated+".gz" {
+			t.Errorf("expected %s to be compressed; got %+v", rotated+".gz", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected a compress event")
+	}
+
+	if pending := logger.PendingCompressions(); len(pending) != 0 {
+		t.Errorf("expected no pending compressions; got %v", pending)
+	}
+	if _, err := os.Stat(rotated); !os.IsNotExist(err) {
+		t.Errorf("expected the rotated file to be removed; got %v", err)
+	}
+}
+
+func TestCompressRotatedImmediately(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMaxLinesPerFile(1), WithCompressRotated(true))
+	events := logger.CompressEvents()
+
+	for i := 0; i < 3; i++ {
+		logger.LogInfo("line")
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-events:
+			if event.Err != nil {
+				t.Errorf("compression failed: %s", event.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected 2 compress events; got %d", i)
+		}
+	}
+}
+
+func TestCompressRotatedDisabled(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMaxLinesPerFile(1))
+
+	logger.LogInfo("first")
+	logger.LogInfo("second")
+
+	if pending := logger.PendingCompressions(); len(pending) != 0 {
+		t.Errorf("expected nothing queued without CompressRotated; got %v", pending)
+	}
+}
@@ -0,0 +1,101 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// RotationPolicy sets the period covered by the log files; a new file with sequence number 1 starts every period.
+type RotationPolicy int
+
+const (
+	// RotateDaily names the log files after the day, e.g. 2025-1-15_1.log. It is the default.
+	RotateDaily RotationPolicy = iota
+	// RotateWeekly names the log files after the ISO 8601 week, e.g. 2025-W03_1.log. Weeks start on Monday and the
+	// week year can differ from the calendar year in the first and last days of the year.
+	RotateWeekly
+)
+
+// WithRotation sets the period covered by the log files; the default is RotateDaily.
+func WithRotation(policy RotationPolicy) Option {
+	return func(l *FileLogger) {
+		l.Rotation = policy
+	}
+}
+
+// logFilePeriod returns the prefix of the names of the log files covering t.
+func logFilePeriod(t time.Time, policy RotationPolicy) string {
+	if policy == RotateWeekly {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf(`%d-W%02d`, year, week)
+	}
+	y, m, d := t.Date()
+	return fmt.Sprintf(`%d-%d-%d`, y, m, d)
+}
+
+// String returns the name of the policy as used in config files.
+func (p RotationPolicy) String() string {
+	switch p {
+	case RotateDaily:
+		return "daily"
+	case RotateWeekly:
+		return "weekly"
+	}
+	return "unknown"
+}
+
+// MarshalText returns the policy name, so that policies are written as text in config files.
+func (p RotationPolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText parses a policy name, "daily" or "weekly", ignoring case.
+func (p *RotationPolicy) UnmarshalText(text []byte) error {
+	for policy := RotateDaily; policy <= RotateWeekly; policy++ {
+		if strings.EqualFold(strings.TrimSpace(string(text)), policy.String()) {
+			*p = policy
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown rotation policy %q", text)
+}
+
+// getpid returns the process ID written in log file names when a logger starts; tests replace it to simulate
+// other processes.
+var getpid = os.Getpid
+
+// WithPIDInFilename inserts the process ID between the date and the sequence number of the log file names,
+// e.g. 2025-1-2_4242_1.log, so that instances of a service sharing the log directory write to separate files.
+func WithPIDInFilename(enabled bool) Option {
+	return func(l *FileLogger) {
+		l.IncludePIDInFilename = enabled
+	}
+}
+
+// filenamePID returns the process ID to write in log file names, or 0 if IncludePIDInFilename is not set.
+func (l *FileLogger) filenamePID() int {
+	if !l.IncludePIDInFilename {
+		return 0
+	}
+	return l.pid
+}
+
+// logFilePrefix returns the part of the names of the log files covering t before the sequence number:
+// the period, followed by the process ID unless pid is 0.
+func logFilePrefix(t time.Time, policy RotationPolicy, pid int) string {
+	period := logFilePeriod(t, policy)
+	if pid == 0 {
+		return period
+	}
+	return fmt.Sprintf("%s_%d", period, pid)
+}
+
+// logFileNamePrefix returns the part of a log file name before the sequence number, as returned by logFilePrefix.
+func logFileNamePrefix(filename string) string {
+	if i := strings.LastIndexByte(filename, '_'); i >= 0 {
+		return filename[:i]
+	}
+	return filename
+}
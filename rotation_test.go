@@ -0,0 +1,169 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestLogFilePeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		policy   RotationPolicy
+		expected string
+	}{
+		{name: "daily", date: time.Date(2025, 1, 15, 12, 0, 0, 0, time.Local), policy: RotateDaily, expected: "2025-1-15"},
+		{name: "weekly", date: time.Date(2025, 1, 15, 12, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2025-W03"},
+		{name: "sunday ends the week", date: time.Date(2025, 1, 19, 23, 59, 0, 0, time.Local), policy: RotateWeekly, expected: "2025-W03"},
+		{name: "monday starts the week", date: time.Date(2025, 1, 20, 0, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2025-W04"},
+		{name: "last week of a 52 week year", date: time.Date(2025, 12, 28, 12, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2025-W52"},
+		{name: "december in the next week year", date: time.Date(2025, 12, 29, 12, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2026-W01"},
+		{name: "last week of a 53 week year", date: time.Date(2026, 12, 31, 12, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2026-W53"},
+		{name: "january in the previous week year", date: time.Date(2027, 1, 3, 12, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2026-W53"},
+		{name: "first week after W53", date: time.Date(2027, 1, 4, 12, 0, 0, 0, time.Local), policy: RotateWeekly, expected: "2027-W01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if period := logFilePeriod(tt.date, tt.policy); period != tt.expected {
+				t.Errorf("expected %s; got %s", tt.expected, period)
+			}
+		})
+	}
+}
+
+func TestOpenLogFileWeekBoundary(t *testing.T) {
+	logDir := t.TempDir()
+	for _, name := range []string{"2025-W52_1.log", "2025-W52_7.log", "2026-W01_2.log", "2026-W10_1.log"} {
+		if err := os.WriteFile(filepath.Join(logDir, name), nil, 0666); err != nil {
+			t.Fatalf("failed to create log file: %s", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected string
+	}{
+		{name: "previous week year", date: time.Date(2025, 12, 28, 12, 0, 0, 0, time.Local), expected: "2025-W52_7.log"},
+		{name: "week reset at year end", date: time.Date(2025, 12, 30, 12, 0, 0, 0, time.Local), expected: "2026-W01_2.log"},
+		{name: "new week", date: time.Date(2026, 1, 6, 12, 0, 0, 0, time.Local), expected: "2026-W02_1.log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logFile, err := openLogFile(logDir, logFilePeriod(tt.date, RotateWeekly), 0, 0)
+			if err != nil {
+				t.Fatalf("failed to open log file: %s", err)
+			}
+			defer logFile.Close()
+
+			if name := filepath.Base(logFile.Name()); name != tt.expected {
+				t.Errorf("expected %s; got %s", tt.expected, name)
+			}
+		})
+	}
+}
+
+func TestWeeklyRotation(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithRotation(RotateWeekly), WithMaxLinesPerFile(2))
+
+	for i := 0; i < 3; i++ {
+		logger.LogInfo("line")
+	}
+
+	if !regexp.MustCompile(`^\d{4}-W\d{2}_2\.log$`).MatchString(filepath.Base(logger.CurrentLogFile.Name())) {
+		t.Errorf("expected the second weekly log file; got %s", logger.CurrentLogFile.Name())
+	}
+}
+
+func TestRotationPolicyText(t *testing.T) {
+	var policy RotationPolicy
+	if err := policy.UnmarshalText([]byte("Weekly")); err != nil || policy != RotateWeekly {
+		t.Errorf("expected weekly; got %s, %v", policy, err)
+	}
+	if text, _ := RotateDaily.MarshalText(); string(text) != "daily" {
+		t.Errorf("expected daily; got %s", text)
+	}
+	if err := policy.UnmarshalText([]byte("hourly")); err == nil {
+		t.Errorf("expected an error for an unknown policy")
+	}
+}
+
+func TestPIDInFilename(t *testing.T) {
+	captureConsole(t)
+	logDir := t.TempDir()
+	defer func(f func() int) { getpid = f }(getpid)
+
+	getpid = func() int { return 4242 }
+	first, err := newLogger(false, logDir, WithPIDInFilename(true), WithMaxLinesPerFile(2))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer first.Close()
+
+	getpid = func() int { return 77 }
+	second, err := newLogger(false, logDir, WithPIDInFilename(true), WithMaxLinesPerFile(2))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer second.Close()
+
+	for i := 0; i < 3; i++ {
+		first.LogInfo("first")
+	}
+	second.LogInfo("second")
+
+	period := logFilePeriod(time.Now(), RotateDaily)
+	tests := []struct {
+		logger   *FileLogger
+		expected string
+	}{
+		{logger: first, expected: period + "_4242_2.log"},
+		{logger: second, expected: period + "_77_1.log"},
+	}
+	for _, tt := range tests {
+		if name := filepath.Base(tt.logger.CurrentLogFile.Name()); name != tt.expected {
+			t.Errorf("expected %s; got %s", tt.expected, name)
+		}
+	}
+}
+
+func TestOpenLogFileIgnoresOtherPIDs(t *testing.T) {
+	logDir := t.TempDir()
+	for _, name := range []string{"2025-1-2_1.log", "2025-1-2_3.log", "2025-1-2_4242_5.log", "2025-1-2_77_2.log"} {
+		if err := os.WriteFile(filepath.Join(logDir, name), nil, 0666); err != nil {
+			t.Fatalf("failed to create log file: %s", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pid      int
+		expected string
+	}{
+		{name: "without pid", expected: "2025-1-2_3.log"},
+		{name: "own pid", pid: 4242, expected: "2025-1-2_4242_5.log"},
+		{name: "other pid", pid: 77, expected: "2025-1-2_77_2.log"},
+		{name: "new pid", pid: 42, expected: "2025-1-2_42_1.log"},
+	}
+
+	date := time.Date(2025, 1, 2, 12, 0, 0, 0, time.Local)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logFile, err := openLogFile(logDir, logFilePrefix(date, RotateDaily, tt.pid), 0, 0)
+			if err != nil {
+				t.Fatalf("failed to open log file: %s", err)
+			}
+			defer logFile.Close()
+
+			if name := filepath.Base(logFile.Name()); name != tt.expected {
+				t.Errorf("expected %s; got %s", tt.expected, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+type scopeContextKey struct{}
+
+// scopedLogger adds the scope field to every message until its scope ends, after which messages are dropped.
+type scopedLogger struct {
+	logger *FieldLogger
+	closed atomic.Bool
+}
+
+// Scope calls fn with a child logger that appends scope=name to every message, then logs
+// `scope_done name=<name> duration_ms=<N>` at DEBUG level. The child drops everything logged after fn returns,
+// except fatal messages, so it must not be kept.
+func (l *FileLogger) Scope(name string, fn func(Logger)) {
+	child, done := l.startScope(name)
+	defer done()
+	fn(child)
+}
+
+// WithScope returns a context carrying a child logger that appends scope=name to every message,
+// which ScopeFromContext returns, and the function that ends the scope like the end of the Scope callback.
+//
+//	ctx, done := l.WithScope(ctx, "payment_processing")
+//	defer done()
+func (l *FileLogger) WithScope(ctx context.Context, name string) (context.Context, func()) {
+	child, done := l.startScope(name)
+	return context.WithValue(ctx, scopeContextKey{}, Logger(child)), done
+}
+
+// ScopeFromContext returns the scoped logger stored in ctx by WithScope, or nil if there is none.
+func ScopeFromContext(ctx context.Context) Logger {
+	child, _ := ctx.Value(scopeContextKey{}).(Logger)
+	return child
+}
+
+// startScope returns the child logger of the scope name and the function that ends it; calling it again does nothing.
+func (l *FileLogger) startScope(name string) (*scopedLogger, func()) {
+	start := time.Now()
+	child := &scopedLogger{logger: l.WithField("scope", name)}
+
+	done := func() {
+		if child.closed.Swap(true) {
+			return
+		}
+		l.logAt(LogLevelDebug, fmt.Sprintf("scope_done name=%s duration_ms=%d", name, time.Since(start).Milliseconds()))
+	}
+	return child, done
+}
+
+func (s *scopedLogger) LogFatal(err error) {
+	s.logger.LogFatal(err)
+}
+
+func (s *scopedLogger) LogError(err error) {
+	if !s.closed.Load() {
+		s.logger.LogError(err)
+	}
+}
+
+func (s *scopedLogger) LogWarn(message string) {
+	if !s.closed.Load() {
+		s.logger.LogWarn(message)
+	}
+}
+
+func (s *scopedLogger) LogInfo(message string) {
+	if !s.closed.Load() {
+		s.logger.LogInfo(message)
+	}
+}
+
+func (s *scopedLogger) LogDebug(message string) {
+	if !s.closed.Load() {
+		s.logger.LogDebug(message)
+	}
+}
+
+func (s *scopedLogger) LogTrace(message string) {
+	if !s.closed.Load() {
+		s.logger.LogTrace(message)
+	}
+}
+
+func (s *scopedLogger) LogBytes(level LogLevel, label string, data []byte) {
+	if !s.closed.Load() {
+		s.logger.LogBytes(level, label, data)
+	}
+}
+
+func (s *scopedLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	if !s.closed.Load() {
+		s.logger.LogChange(field, from, to, extra)
+	}
+}
+
+func (s *scopedLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	if !s.closed.Load() {
+		s.logger.LogDiff(level, label, before, after)
+	}
+}
+
+func (s *scopedLogger) LogFatalf(format string, args ...interface{}) {
+	s.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (s *scopedLogger) LogErrorf(format string, args ...interface{}) {
+	s.LogError(fmt.Errorf(format, args...))
+}
+
+func (s *scopedLogger) LogWarnf(format string, args ...interface{}) {
+	s.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (s *scopedLogger) LogInfof(format string, args ...interface{}) {
+	s.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (s *scopedLogger) LogDebugf(format string, args ...interface{}) {
+	s.LogDebug(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var escaped Logger
+	logger.Scope("payment_processing", func(l Logger) {
+		l.LogInfo("charging card")
+		l.LogError(errors.New("card declined"))
+		escaped = l
+	})
+	escaped.LogInfo("after the scope")
+	logger.LogInfo("outside the scope")
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	expected := []string{
+		"INFO charging card scope=payment_processing",
+		"ERROR card declined scope=payment_processing",
+		"DEBUG scope_done name=payment_processing duration_ms=",
+		"INFO outside the scope",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines; got %q", len(expected), lines)
+	}
+	for i, line := range lines {
+		if !strings.Contains(line, expected[i]) {
+			t.Errorf("expected line %d to contain %q; got %q", i, expected[i], line)
+		}
+	}
+}
+
+func TestWithScope(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	if l := ScopeFromContext(context.Background()); l != nil {
+		t.Errorf("expected no scoped logger; got %v", l)
+	}
+
+	ctx, done := logger.WithScope(context.Background(), "import")
+	ScopeFromContext(ctx).LogWarn("skipped row")
+	done()
+	done()
+	ScopeFromContext(ctx).LogWarn("after done")
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "WARNING skipped row scope=import") {
+		t.Errorf("expected the scope field; got %q", content)
+	}
+	if strings.Contains(content, "after done") {
+		t.Errorf("expected messages after the scope ended to be dropped; got %q", content)
+	}
+	if strings.Count(content, "scope_done name=import") != 1 {
+		t.Errorf("expected scope_done once; got %q", content)
+	}
+}
@@ -0,0 +1,163 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLogSecurityEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		outcome   string
+		fields    map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "login success",
+			eventType: "login",
+			outcome:   SecurityOutcomeSuccess,
+			expected:  `INFO security event.category=authentication event.outcome=success event.type="login" file.path="/admin" user.name="alice"`,
+		},
+		{
+			name:      "login failure",
+			eventType: "login",
+			outcome:   SecurityOutcomeFailure,
+			fields:    map[string]interface{}{"source.ip": "203.0.113.4"},
+			expected:  `WARNING security event.category=authentication event.outcome=failure event.type="login" file.path="/admin" source.ip="203.0.113.4" user.name="alice"`,
+		},
+		{
+			name:      "unknown outcome",
+			eventType: "login",
+			outcome:   "maybe",
+			expected:  `WARNING security event.category=authentication event.outcome=unknown event.type="login" file.path="/admin" user.name="alice"`,
+		},
+		{
+			name:      "permission change",
+			eventType: "permission_change",
+			outcome:   SecurityOutcomeSuccess,
+			expected:  `INFO security event.category=authentication event.outcome=success event.type="permission_change" file.path="/admin" user.name="alice"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogSecurityEvent(tt.eventType, "alice", "/admin", tt.outcome, tt.fields)
+
+			if content := readLogFile(t, logger); !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestLogSecurityEventInjection(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	actor := "mallory event.outcome=success\n2026/10/16 12:00:00 INFO security event.outcome=success user.name=admin"
+	logger.LogSecurityEvent("login", actor, "/admin\nfake", SecurityOutcomeFailure, map[string]interface{}{"source.ip": "1.2.3.4 x=y"})
+
+	content := readLogFile(t, logger)
+	if lines := strings.Count(content, "\n"); lines != 1 {
+		t.Fatalf("expected a single line; got %d in %q", lines, content)
+	}
+	expected := `user.name="mallory event.outcome=success\n2026/10/16 12:00:00 INFO security event.outcome=success user.name=admin"`
+	if !strings.Contains(content, expected) || !strings.Contains(content, `file.path="/admin\nfake" source.ip="1.2.3.4 x=y"`) {
+		t.Errorf("expected the caller values to be quoted; got %q", content)
+	}
+}
+
+func TestLogSecurityEventAlwaysLogged(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelFatal))
+	logger.AddSuppressRule(FieldMatchRule("user.name", "bob"))
+
+	logger.LogSecurityEvent("logout", "bob", "/", SecurityOutcomeSuccess, nil)
+
+	if content := readLogFile(t, logger); !strings.Contains(content, "INFO security") {
+		t.Errorf("expected the event regardless of the minimum level and suppress rules; got %q", content)
+	}
+}
+
+func TestSecurityAuditFile(t *testing.T) {
+	captureConsole(t)
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	logger := newTestLogger(t, WithSecurityAuditFile(auditPath))
+
+	logger.LogInfo("regular message")
+	logger.LogSecurityEvent("login", "alice", "/admin", SecurityOutcomeFailure, nil)
+	logger.AddTag("sso")
+	logger.LogSecurityEvent("login", "carol", "/admin", SecurityOutcomeSuccess, nil)
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	audit, err := os.ReadFile(auditPath)
+	if err != nil {
+		t.Fatalf("failed to read audit file: %s", err)
+	}
+	if !strings.Contains(string(audit), "WARNING security event.category=authentication") {
+		t.Errorf("expected the event in the audit file; got %q", audit)
+	}
+	if !strings.Contains(string(audit), `user.name="carol" tags=[sso]`) {
+		t.Errorf("expected the event with the logger tags in the audit file; got %q", audit)
+	}
+	if strings.Contains(string(audit), "regular message") {
+		t.Errorf("expected only security events in the audit file; got %q", audit)
+	}
+	if content := readLogFile(t, logger); strings.Contains(content, "security") {
+		t.Errorf("expected no security events in the log file; got %q", content)
+	}
+}
+
+func TestSecurityAuditFileFallback(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithSecurityAuditFile(filepath.Join(t.TempDir(), "missing", "audit.log")))
+
+	logger.LogSecurityEvent("login", "alice", "/admin", SecurityOutcomeSuccess, nil)
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "ERROR failed writing security audit file") || !strings.Contains(content, "INFO security") {
+		t.Errorf("expected the error and the event in the log file; got %q", content)
+	}
+}
+
+func TestSecurityAuditFileEncrypted(t *testing.T) {
+	captureConsole(t)
+	key := bytes.Repeat([]byte{5}, 32)
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	logger := newTestLogger(t, WithSecurityAuditFile(auditPath), WithEncryptionKey(key))
+
+	logger.LogSecurityEvent("login", "alice", "/admin", SecurityOutcomeFailure, nil)
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+
+	audit, err := os.ReadFile(auditPath)
+	if err != nil {
+		t.Fatalf("failed to read audit file: %s", err)
+	}
+	if bytes.Contains(audit, []byte(`user.name="alice"`)) {
+		t.Fatalf("expected the audit file to be encrypted; got %q", audit)
+	}
+
+	r, err := NewDecryptingReader(bytes.NewReader(audit), key)
+	if err != nil {
+		t.Fatalf("failed to create decrypting reader: %s", err)
+	}
+	decrypted, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to decrypt audit file: %s", err)
+	}
+	if !strings.Contains(string(decrypted), "WARNING security event.category=authentication") {
+		t.Errorf("expected the event in the decrypted audit file; got %q", decrypted)
+	}
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+type slogHandler struct {
+	logger *FileLogger
+	fields map[string]interface{}
+	prefix string
+}
+
+// NewSlogHandler returns a slog.Handler that writes records through l, with their attributes appended as key=value pairs.
+// Attributes inside groups are written with dotted keys, e.g. `req.method=GET`.
+// Records below slog.LevelDebug are logged as trace messages and records at slog.LevelError or above as errors.
+func NewSlogHandler(l *FileLogger) slog.Handler {
+	return &slogHandler{logger: l}
+}
+
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.logger.enabled(slogLevel(level))
+}
+
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	fields := h.copyFields(r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		addSlogAttr(fields, h.prefix, a)
+		return true
+	})
+
+	message := r.Message
+	if len(fields) > 0 {
+		message = fmt.Sprintf("%s %s", message, formatFields(fields))
+	}
+	h.logger.logAt(slogLevel(r.Level), message)
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	fields := h.copyFields(len(attrs))
+	for _, a := range attrs {
+		addSlogAttr(fields, h.prefix, a)
+	}
+	return &slogHandler{logger: h.logger, fields: fields, prefix: h.prefix}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &slogHandler{logger: h.logger, fields: h.fields, prefix: h.prefix + name + "."}
+}
+
+func (h *slogHandler) copyFields(extra int) map[string]interface{} {
+	fields := make(map[string]interface{}, len(h.fields)+extra)
+	for k, v := range h.fields {
+		fields[k] = v
+	}
+	return fields
+}
+
+// addSlogAttr adds a to fields under prefix, flattening groups into dotted keys.
+func addSlogAttr(fields map[string]interface{}, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix = prefix + a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			addSlogAttr(fields, prefix, ga)
+		}
+		return
+	}
+	fields[prefix+a.Key] = a.Value.Any()
+}
+
+func slogLevel(level slog.Level) LogLevel {
+	switch {
+	case level < slog.LevelDebug:
+		return LogLevelTrace
+	case level < slog.LevelInfo:
+		return LogLevelDebug
+	case level < slog.LevelWarn:
+		return LogLevelInfo
+	case level < slog.LevelError:
+		return LogLevelWarn
+	default:
+		return LogLevelError
+	}
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogHandler(t *testing.T) {
+	captureConsole(t)
+
+	tests := []struct {
+		name     string
+		log      func(l *slog.Logger)
+		expected string
+	}{
+		{
+			name:     "attributes",
+			log:      func(l *slog.Logger) { l.Info("request served", "status", 200, "path", "/health") },
+			expected: "INFO request served path=/health status=200",
+		},
+		{
+			name:     "no attributes",
+			log:      func(l *slog.Logger) { l.Warn("disk almost full") },
+			expected: "WARNING disk almost full",
+		},
+		{
+			name:     "error level",
+			log:      func(l *slog.Logger) { l.Error("query failed", "table", "users") },
+			expected: "ERROR query failed table=users",
+		},
+		{
+			name:     "with attrs",
+			log:      func(l *slog.Logger) { l.With("request_id", "abc").Info("done", "took", "5ms") },
+			expected: "INFO done request_id=abc took=5ms",
+		},
+		{
+			name:     "with group",
+			log:      func(l *slog.Logger) { l.With("service", "api").WithGroup("req").Info("done", "method", "GET") },
+			expected: "INFO done req.method=GET service=api",
+		},
+		{
+			name:     "group attribute",
+			log:      func(l *slog.Logger) { l.Info("done", slog.Group("db", "host", "db1", "port", 5432)) },
+			expected: "INFO done db.host=db1 db.port=5432",
+		},
+		{
+			name:     "empty group is dropped",
+			log:      func(l *slog.Logger) { l.WithGroup("req").Info("done", slog.Group("empty")) },
+			expected: "INFO done",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newTestLogger(t)
+			tt.log(slog.New(NewSlogHandler(logger)))
+
+			if content := readLogFile(t, logger); !strings.HasSuffix(content, tt.expected+"\n") {
+				t.Errorf("expected line ending with %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestSlogHandlerEnabled(t *testing.T) {
+	logger := newTestLogger(t, WithMinLevel(LogLevelWarn))
+	handler := NewSlogHandler(logger)
+
+	tests := []struct {
+		level    slog.Level
+		expected bool
+	}{
+		{level: slog.LevelDebug - 4, expected: false},
+		{level: slog.LevelDebug, expected: false},
+		{level: slog.LevelInfo, expected: false},
+		{level: slog.LevelWarn, expected: true},
+		{level: slog.LevelError, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			if actual := handler.Enabled(context.Background(), tt.level); actual != tt.expected {
+				t.Errorf("expected %t; got %t", tt.expected, actual)
+			}
+		})
+	}
+}
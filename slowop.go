@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+)
+
+// WithSlowOperationHook sets a hook called after every slow operation is logged by LogSlowOperation,
+// e.g. for real-time alerting.
+func WithSlowOperationHook(fn func(name string, actual time.Duration)) Option {
+	return func(l *FileLogger) {
+		l.SlowOperationHook = fn
+	}
+}
+
+// LogSlowOperation logs an operation that took longer than its latency budget at WARNING level as `slow_operation`
+// with the operation, threshold_ms, actual_ms and over_by_ms fields, and severity_ratio, actual divided by threshold,
+// if threshold is positive, followed by the extra fields. SlowOperationHook is called synchronously once written.
+func (l *FileLogger) LogSlowOperation(name string, threshold, actual time.Duration, fields map[string]interface{}) {
+	l.logAt(LogLevelWarn, formatSlowOperation(name, threshold, actual, fields))
+
+	if l.SlowOperationHook != nil {
+		l.SlowOperationHook(name, actual)
+	}
+}
+
+func formatSlowOperation(name string, threshold, actual time.Duration, extra map[string]interface{}) string {
+	fields := make(map[string]interface{}, len(extra)+5)
+	for k, v := range extra {
+		fields[k] = v
+	}
+	fields["operation"] = name
+	fields["threshold_ms"] = threshold.Milliseconds()
+	fields["actual_ms"] = actual.Milliseconds()
+	fields["over_by_ms"] = (actual - threshold).Milliseconds()
+	if threshold > 0 {
+		fields["severity_ratio"] = fmt.Sprintf("%.2f", float64(actual)/float64(threshold))
+	}
+	return fmt.Sprintf("slow_operation %s", formatFields(fields))
+}
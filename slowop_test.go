@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogSlowOperation(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		actual    time.Duration
+		fields    map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "twice the budget",
+			threshold: 200 * time.Millisecond,
+			actual:    400 * time.Millisecond,
+			expected:  "WARNING slow_operation actual_ms=400 operation=checkout over_by_ms=200 severity_ratio=2.00 threshold_ms=200",
+		},
+		{
+			name:      "just over the budget",
+			threshold: time.Second,
+			actual:    1250 * time.Millisecond,
+			fields:    map[string]interface{}{"region": "eu"},
+			expected:  "WARNING slow_operation actual_ms=1250 operation=checkout over_by_ms=250 region=eu severity_ratio=1.25 threshold_ms=1000",
+		},
+		{
+			name:      "sub-millisecond precision",
+			threshold: 1500 * time.Microsecond,
+			actual:    4500 * time.Microsecond,
+			expected:  "WARNING slow_operation actual_ms=4 operation=checkout over_by_ms=3 severity_ratio=3.00 threshold_ms=1",
+		},
+		{
+			name:     "no threshold",
+			actual:   time.Second,
+			expected: "WARNING slow_operation actual_ms=1000 operation=checkout over_by_ms=1000 threshold_ms=0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+
+			logger.LogSlowOperation("checkout", tt.threshold, tt.actual, tt.fields)
+
+			if content := readLogFile(t, logger); !strings.Contains(content, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestSlowOperationHook(t *testing.T) {
+	captureConsole(t)
+	var calls []string
+	logger := newTestLogger(t, WithSlowOperationHook(func(name string, actual time.Duration) {
+		calls = append(calls, name)
+		if actual != 3*time.Second {
+			t.Errorf("expected 3s; got %s", actual)
+		}
+	}))
+
+	logger.LogSlowOperation("export", time.Second, 3*time.Second, nil)
+
+	if len(calls) != 1 || calls[0] != "export" {
+		t.Errorf("expected the hook to be called once for export; got %v", calls)
+	}
+}
@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Span runs fn with a child logger that adds the operation name and start time to every line,
+// and logs a span_end line with the duration once fn returns.
+// If fn panics, a span_panic error is logged before the panic is re-raised.
+// Span returns the context error if ctx was cancelled or timed out while fn was running.
+func (l *FileLogger) Span(ctx context.Context, name string, fn func(ctx context.Context, log Logger)) error {
+	start := time.Now()
+	inner := l.WithField("span", name).WithField("span_start", start.Format(time.RFC3339Nano))
+
+	defer func() {
+		if r := recover(); r != nil {
+			inner.LogError(fmt.Errorf("span_panic duration_ms=%d: %v", time.Since(start).Milliseconds(), r))
+			panic(r)
+		}
+	}()
+
+	fn(ctx, inner)
+	inner.LogInfo(fmt.Sprintf("span_end duration_ms=%d", time.Since(start).Milliseconds()))
+
+	return ctx.Err()
+}
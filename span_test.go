@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSpan(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	err := logger.Span(context.Background(), "checkout", func(ctx context.Context, log Logger) {
+		log.LogInfo("charging card")
+		log.LogDebug("card charged")
+	})
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines; got %d: %q", len(lines), lines)
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, "span=checkout span_start=") {
+			t.Errorf("expected the span fields in every line; got %q", line)
+		}
+	}
+	if !strings.Contains(lines[2], "INFO span_end duration_ms=") {
+		t.Errorf("expected a span_end line with the duration; got %q", lines[2])
+	}
+}
+
+func TestSpanCancelledContext(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := logger.Span(ctx, "import", func(ctx context.Context, log Logger) {
+		cancel()
+	})
+	if err != context.Canceled {
+		t.Errorf("expected %v; got %v", context.Canceled, err)
+	}
+}
+
+func TestSpanPanic(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	defer func() {
+		r := recover()
+		if r != "boom" {
+			t.Fatalf("expected the panic to be re-raised; got %v", r)
+		}
+
+		content := readLogFile(t, logger)
+		if !strings.Contains(content, "ERROR span_panic duration_ms=") || !strings.Contains(content, ": boom span=checkout") {
+			t.Errorf("expected a span_panic error line; got %q", content)
+		}
+		if strings.Contains(content, "span_end") {
+			t.Errorf("expected no span_end line after a panic; got %q", content)
+		}
+	}()
+
+	logger.Span(context.Background(), "checkout", func(ctx context.Context, log Logger) {
+		panic("boom")
+	})
+}
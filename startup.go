@@ -0,0 +1,126 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// WithRedactConfigKeys replaces the values of the given keys with "[REDACTED]" in LogStartupConfig output.
+// Keys are matched case-insensitively against the JSON field names at any depth.
+func WithRedactConfigKeys(keys ...string) Option {
+	return func(l *FileLogger) {
+		l.RedactConfigKeys = keys
+	}
+}
+
+// LogStartupConfig logs the application configuration as single-line JSON at INFO level with the startup_config label.
+// Fields tagged `json:"-"` or `log:"-"` are left out and the keys listed in RedactConfigKeys are redacted.
+func (l *FileLogger) LogStartupConfig(cfg interface{}) {
+	data, err := json.Marshal(l.configValue(reflect.ValueOf(cfg)))
+	if err != nil {
+		l.LogError(fmt.Errorf("failed marshalling startup config: %w", err))
+		return
+	}
+
+	l.LogInfo(fmt.Sprintf("startup_config %s", data))
+}
+
+// configValue converts v into plain maps and slices following the encoding/json field naming rules,
+// so that excluded fields can be dropped and sensitive keys redacted before marshalling.
+func (l *FileLogger) configValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return l.configValue(v.Elem())
+	case reflect.Struct:
+		if _, ok := v.Interface().(json.Marshaler); ok {
+			return v.Interface()
+		}
+		fields := make(map[string]interface{})
+		l.addConfigFields(fields, v)
+		return fields
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		entries := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			entries[key] = l.redactConfigValue(key, iter.Value())
+		}
+		return entries
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && (v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8) {
+			return v.Interface()
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = l.configValue(v.Index(i))
+		}
+		return items
+	default:
+		return v.Interface()
+	}
+}
+
+func (l *FileLogger) addConfigFields(fields map[string]interface{}, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		if field.Tag.Get("log") == "-" {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		value := v.Field(i)
+		if field.Anonymous && name == "" {
+			if value.Kind() == reflect.Pointer {
+				if value.IsNil() {
+					continue
+				}
+				value = value.Elem()
+			}
+			if value.Kind() == reflect.Struct {
+				l.addConfigFields(fields, value)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		if strings.Contains(opts, "omitempty") && value.IsZero() {
+			continue
+		}
+		fields[name] = l.redactConfigValue(name, value)
+	}
+}
+
+func (l *FileLogger) redactConfigValue(key string, v reflect.Value) interface{} {
+	for _, redacted := range l.RedactConfigKeys {
+		if strings.EqualFold(key, redacted) {
+			return redactedValue
+		}
+	}
+	return l.configValue(v)
+}
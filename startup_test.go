@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+type testDatabaseConfig struct {
+	Host     string `json:"host"`
+	Password string `json:"password"`
+}
+
+type testStartupConfig struct {
+	Name     string             `json:"name"`
+	Port     int                `json:"port"`
+	Debug    bool               `json:"debug,omitempty"`
+	Database testDatabaseConfig `json:"database"`
+	Token    string             `json:"-"`
+	Internal string             `log:"-"`
+	Labels   map[string]string  `json:"labels"`
+}
+
+func TestLogStartupConfig(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.RedactConfigKeys = []string{"password", "API_KEY"}
+
+	logger.LogStartupConfig(testStartupConfig{
+		Name:     "api",
+		Port:     8080,
+		Database: testDatabaseConfig{Host: "db.local", Password: "hunter2"},
+		Token:    "secret-token",
+		Internal: "internal-value",
+		Labels:   map[string]string{"api_key": "abc123", "team": "core"},
+	})
+
+	content, err := os.ReadFile(logger.CurrentLogFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	line := strings.TrimSpace(string(content))
+	if strings.Count(line, "\n") != 0 {
+		t.Fatalf("expected a single line; got %q", line)
+	}
+
+	_, data, found := strings.Cut(line, "INFO startup_config ")
+	if !found {
+		t.Fatalf("expected an INFO startup_config line; got %q", line)
+	}
+
+	var logged map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &logged); err != nil {
+		t.Fatalf("failed to parse logged config: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"name": "api",
+		"port": float64(8080),
+		"database": map[string]interface{}{
+			"host":     "db.local",
+			"password": redactedValue,
+		},
+		"labels": map[string]interface{}{
+			"api_key": redactedValue,
+			"team":    "core",
+		},
+	}
+	got, _ := json.Marshal(logged)
+	want, _ := json.Marshal(expected)
+	if string(got) != string(want) {
+		t.Errorf("expected config %s; got %s", want, got)
+	}
+
+	for _, secret := range []string{"hunter2", "secret-token", "internal-value", "abc123"} {
+		if strings.Contains(line, secret) {
+			t.Errorf("expected %q to be excluded from the log", secret)
+		}
+	}
+}
+
+func TestLogStartupConfigNil(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var cfg *testStartupConfig
+	logger.LogStartupConfig(cfg)
+
+	content, err := os.ReadFile(logger.CurrentLogFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read log file: %s", err)
+	}
+	if !strings.HasSuffix(strings.TrimSpace(string(content)), "INFO startup_config null") {
+		t.Errorf("expected a null config; got %q", content)
+	}
+}
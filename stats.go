@@ -0,0 +1,24 @@
+package logger
+
+// LoggerStats holds counters about the output of a FileLogger.
+type LoggerStats struct {
+	// ThrottledMessages is the number of writes suppressed by loggers returned from Throttle.
+	ThrottledMessages uint64
+	// DuplicateCount is the number of entries skipped because they were already in the log file; see WithCrossProcessDedup.
+	DuplicateCount uint64
+	// DroppedDBEntries is the number of entries not inserted because the queue of a database output was full;
+	// see AddDBOutput.
+	DroppedDBEntries uint64
+	// Outputs holds the bytes and messages written to each named output, keyed by name.
+	Outputs map[string]OutputStats
+}
+
+// Stats returns a snapshot of the logger's counters.
+func (l *FileLogger) Stats() LoggerStats {
+	return LoggerStats{
+		ThrottledMessages: l.throttled.Load(),
+		DuplicateCount:    l.duplicates.Load(),
+		DroppedDBEntries:  l.dbDropped.Load(),
+		Outputs:           l.NamedOutputStats(),
+	}
+}
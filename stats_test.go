@@ -0,0 +1,23 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var output bytes.Buffer
+	logger.AddNamedOutput(OutputConfig{Name: "audit", Writer: &output, MinLevel: LogLevelInfo})
+	logger.LogInfo("info message")
+
+	stats := logger.Stats()
+	if stats.ThrottledMessages != 0 {
+		t.Errorf("expected 0 throttled messages; got %d", stats.ThrottledMessages)
+	}
+	if stats.Outputs["audit"].Messages != 1 || stats.Outputs["audit"].Bytes != uint64(output.Len()) {
+		t.Errorf("expected 1 message and %d bytes for audit; got %+v", output.Len(), stats.Outputs["audit"])
+	}
+}
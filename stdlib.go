@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"io"
+	"log"
+	"strings"
+	"sync"
+)
+
+var (
+	stdlibMu sync.Mutex
+	// stdlibConsole writes console output to the original stdlib destination while the stdlib logger is wrapped.
+	// It is nil otherwise, and console output goes through the stdlib default logger.
+	stdlibConsole *log.Logger
+	stdlibFlags   int
+)
+
+// console returns the logger that console output is written to.
+func console() *log.Logger {
+	stdlibMu.Lock()
+	defer stdlibMu.Unlock()
+
+	if stdlibConsole != nil {
+		return stdlibConsole
+	}
+	return log.Default()
+}
+
+type splitWriter struct {
+	logger *FileLogger
+	level  LogLevel
+}
+
+// WriterAt returns a writer that logs every line written to it at the given level.
+// To redirect the stdlib logger use WrapStdlib instead, which keeps console output from looping back into the writer.
+func (l *FileLogger) WriterAt(level LogLevel) io.Writer {
+	return splitWriter{logger: l, level: level}
+}
+
+func (w splitWriter) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimSuffix(string(p), "\n"), "\n") {
+		w.logger.logAt(w.level, line)
+	}
+	return len(p), nil
+}
+
+// WrapStdlib redirects the output of the stdlib default logger, e.g. log.Printf calls in third-party packages,
+// to l at the given level. The console output of the logger keeps going to the original stdlib destination.
+// Calling it again replaces the previous redirection.
+func (l *FileLogger) WrapStdlib(level LogLevel) {
+	stdlibMu.Lock()
+	defer stdlibMu.Unlock()
+
+	if stdlibConsole == nil {
+		stdlibFlags = log.Flags()
+		stdlibConsole = log.New(log.Writer(), log.Prefix(), stdlibFlags)
+	}
+	log.SetOutput(l.WriterAt(level))
+	log.SetFlags(0)
+}
+
+// UnwrapStdlib restores the output and flags the stdlib default logger had before WrapStdlib was called.
+// It does nothing if the stdlib logger is not wrapped.
+func (l *FileLogger) UnwrapStdlib() {
+	stdlibMu.Lock()
+	defer stdlibMu.Unlock()
+
+	if stdlibConsole == nil {
+		return
+	}
+	log.SetOutput(stdlibConsole.Writer())
+	log.SetFlags(stdlibFlags)
+	stdlibConsole = nil
+}
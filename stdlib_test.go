@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWrapStdlib(t *testing.T) {
+	consoleOutput := captureConsole(t)
+	log.SetFlags(log.Lshortfile)
+	logger := newTestLogger(t)
+
+	logger.WrapStdlib(LogLevelWarn)
+	defer logger.UnwrapStdlib()
+	log.Printf("test %d", 42)
+
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, "WARNING test 42\n") {
+		t.Errorf("expected stdlib output in the log file; got %q", content)
+	}
+	if !strings.Contains(consoleOutput.String(), "WARNING test 42") {
+		t.Errorf("expected console output to keep going to the original destination; got %q", consoleOutput.String())
+	}
+
+	logger.UnwrapStdlib()
+	consoleOutput.Reset()
+	log.Print("after unwrap")
+
+	if strings.Contains(readLogFile(t, logger), "after unwrap") {
+		t.Errorf("expected stdlib output not to reach the log file after unwrapping")
+	}
+	if !strings.Contains(consoleOutput.String(), "after unwrap") {
+		t.Errorf("expected stdlib output to be restored; got %q", consoleOutput.String())
+	}
+	if log.Flags() != log.Lshortfile {
+		t.Errorf("expected stdlib flags %d to be restored; got %d", log.Lshortfile, log.Flags())
+	}
+}
+
+func TestWrapStdlibRepeated(t *testing.T) {
+	consoleOutput := captureConsole(t)
+	logger := newTestLogger(t)
+
+	logger.WrapStdlib(LogLevelInfo)
+	logger.WrapStdlib(LogLevelError)
+	log.Print("repeated")
+	logger.UnwrapStdlib()
+	logger.UnwrapStdlib()
+
+	if content := readLogFile(t, logger); !strings.HasSuffix(content, "ERROR repeated\n") {
+		t.Errorf("expected the last wrap to apply; got %q", content)
+	}
+
+	consoleOutput.Reset()
+	log.Print("restored")
+	if consoleOutput.String() != "restored\n" {
+		t.Errorf("expected the original output to be restored; got %q", consoleOutput.String())
+	}
+}
+
+func TestWrapStdlibConcurrent(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.WrapStdlib(LogLevelInfo)
+			log.Print("concurrent")
+			logger.UnwrapStdlib()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWriterAt(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	if _, err := logger.WriterAt(LogLevelInfo).Write([]byte("first\nsecond\n")); err != nil {
+		t.Fatalf("failed to write: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "INFO first") || !strings.HasSuffix(lines[1], "INFO second") {
+		t.Errorf("expected one entry per line; got %q", lines)
+	}
+}
@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FilterRule decides whether an entry is suppressed; see AddSuppressRule.
+// Match may be called concurrently and must be safe for concurrent use.
+type FilterRule interface {
+	Match(level LogLevel, message string, fields map[string]interface{}) bool
+}
+
+type suppressRule struct {
+	id   int
+	rule FilterRule
+}
+
+// AddSuppressRule drops every entry below FATAL level matching rule before it is written anywhere,
+// and returns the id to remove it with.
+// The fields passed to the rule are the key=value pairs found in the message, e.g. those appended by WithField
+// or the *With methods, with their values as strings.
+func (l *FileLogger) AddSuppressRule(rule FilterRule) int {
+	l.suppressMu.Lock()
+	defer l.suppressMu.Unlock()
+
+	l.nextSuppressID++
+	l.suppressRules = append(l.suppressRules, suppressRule{id: l.nextSuppressID, rule: rule})
+	return l.nextSuppressID
+}
+
+// RemoveSuppressRule removes the rule with the given id, if any.
+func (l *FileLogger) RemoveSuppressRule(id int) {
+	l.suppressMu.Lock()
+	defer l.suppressMu.Unlock()
+
+	for i, r := range l.suppressRules {
+		if r.id == id {
+			l.suppressRules = append(l.suppressRules[:i:i], l.suppressRules[i+1:]...)
+			return
+		}
+	}
+}
+
+// SuppressedCount returns the number of entries dropped by suppress rules.
+func (l *FileLogger) SuppressedCount() uint64 {
+	return l.suppressed.Load()
+}
+
+// suppress reports whether an entry is matched by a suppress rule, counting it if so.
+// Fatal and emergency messages are never suppressed.
+func (l *FileLogger) suppress(level LogLevel, message string) bool {
+	if level >= LogLevelFatal {
+		return false
+	}
+
+	l.suppressMu.RLock()
+	rules := l.suppressRules
+	l.suppressMu.RUnlock()
+	if len(rules) == 0 {
+		return false
+	}
+
+	fields := parseFields(message)
+	for _, r := range rules {
+		if r.rule.Match(level, message, fields) {
+			l.suppressed.Add(1)
+			return true
+		}
+	}
+	return false
+}
+
+type fieldMatchRule struct {
+	key   string
+	value string
+}
+
+// FieldMatchRule returns a rule matching the entries whose field key equals value, compared as text.
+func FieldMatchRule(key string, value interface{}) FilterRule {
+	return fieldMatchRule{key: key, value: fmt.Sprint(value)}
+}
+
+func (r fieldMatchRule) Match(level LogLevel, message string, fields map[string]interface{}) bool {
+	value, ok := fields[r.key]
+	return ok && fmt.Sprint(value) == r.value
+}
+
+type regexpMatchRule struct {
+	key string
+	re  *regexp.Regexp
+}
+
+// RegexpMatchRule returns a rule matching the entries whose field key matches re.
+// An empty key matches re against the whole message instead.
+func RegexpMatchRule(key string, re *regexp.Regexp) FilterRule {
+	return regexpMatchRule{key: key, re: re}
+}
+
+func (r regexpMatchRule) Match(level LogLevel, message string, fields map[string]interface{}) bool {
+	if r.key == "" {
+		return r.re.MatchString(message)
+	}
+	value, ok := fields[r.key]
+	return ok && r.re.MatchString(fmt.Sprint(value))
+}
+
+// parseFields returns the space separated key=value pairs of message. Quoted values are unquoted.
+func parseFields(message string) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for rest := message; rest != ""; {
+		var token string
+		token, rest, _ = strings.Cut(rest, " ")
+		key, value, found := strings.Cut(token, "=")
+		if !found || key == "" {
+			continue
+		}
+
+		if strings.HasPrefix(value, `"`) {
+			if quoted, err := strconv.QuotedPrefix(value + " " + rest); err == nil {
+				rest = strings.TrimPrefix((value + " " + rest)[len(quoted):], " ")
+				value, _ = strconv.Unquote(quoted)
+			}
+		}
+		fields[key] = value
+	}
+	return fields
+}
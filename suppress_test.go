@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestSuppressRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       FilterRule
+		suppressed []string
+		kept       []string
+	}{
+		{
+			name:       "field match",
+			rule:       FieldMatchRule("user_id", "bot"),
+			suppressed: []string{"request handled user_id=bot"},
+			kept:       []string{"request handled user_id=alice", "user_id is bot"},
+		},
+		{
+			name:       "field match with number",
+			rule:       FieldMatchRule("status", 404),
+			suppressed: []string{"GET /favicon.ico status=404"},
+			kept:       []string{"GET / status=200"},
+		},
+		{
+			name:       "regexp on field",
+			rule:       RegexpMatchRule("path", regexp.MustCompile(`^/health`)),
+			suppressed: []string{"request path=/healthz", "request path=/health/ready"},
+			kept:       []string{"request path=/api/health"},
+		},
+		{
+			name:       "regexp on quoted field",
+			rule:       RegexpMatchRule("error", regexp.MustCompile(`broken pipe`)),
+			suppressed: []string{`write failed error="write tcp: broken pipe" bytes=10`},
+			kept:       []string{`write failed error="connection reset" bytes=10`},
+		},
+		{
+			name:       "regexp on message",
+			rule:       RegexpMatchRule("", regexp.MustCompile(`^noisy`)),
+			suppressed: []string{"noisy library output"},
+			kept:       []string{"not so noisy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+			logger.AddSuppressRule(tt.rule)
+
+			for _, message := range append(tt.suppressed, tt.kept...) {
+				logger.LogInfo(message)
+			}
+
+			content := readLogFile(t, logger)
+			for _, message := range tt.suppressed {
+				if strings.Contains(content, message) {
+					t.Errorf("expected %q to be suppressed", message)
+				}
+			}
+			for _, message := range tt.kept {
+				if !strings.Contains(content, message) {
+					t.Errorf("expected %q to be written", message)
+				}
+			}
+			if got := logger.SuppressedCount(); got != uint64(len(tt.suppressed)) {
+				t.Errorf("expected %d suppressed entries; got %d", len(tt.suppressed), got)
+			}
+		})
+	}
+}
+
+func TestRemoveSuppressRule(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	id := logger.AddSuppressRule(FieldMatchRule("user_id", "bot"))
+	logger.WithField("user_id", "bot").LogInfo("first")
+	logger.RemoveSuppressRule(id)
+	logger.WithField("user_id", "bot").LogInfo("second")
+
+	content := readLogFile(t, logger)
+	if strings.Contains(content, "first") || !strings.Contains(content, "second user_id=bot") {
+		t.Errorf("expected only the entry after removing the rule; got %q", content)
+	}
+}
+
+func TestSuppressRuleSkipsErrorsByField(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.AddSuppressRule(FieldMatchRule("code", "E42"))
+
+	logger.LogError(errors.New("failed code=E42"))
+	if content := readLogFile(t, logger); content != "" {
+		t.Errorf("expected the error to be suppressed; got %q", content)
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		message  string
+		expected map[string]interface{}
+	}{
+		{message: "no fields here", expected: map[string]interface{}{}},
+		{message: "done a=1 b=two", expected: map[string]interface{}{"a": "1", "b": "two"}},
+		{message: `query="SELECT 1 FROM t" rows=1`, expected: map[string]interface{}{"query": "SELECT 1 FROM t", "rows": "1"}},
+		{message: `x= =y error="unterminated`, expected: map[string]interface{}{"x": "", "error": `"unterminated`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			if got := parseFields(tt.message); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v; got %v", tt.expected, got)
+			}
+		})
+	}
+}
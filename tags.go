@@ -0,0 +1,160 @@
+package logger
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var _ Logger = (*TaggedLogger)(nil)
+
+// TaggedLogger is a child logger that appends `tags=[<tag>,...]` to every message.
+// It writes through the FileLogger it was created from, so it shares its files, outputs, minimum level and rules.
+type TaggedLogger struct {
+	logger *FileLogger
+	mu     sync.RWMutex
+	tags   []string
+}
+
+// WithTags returns a child logger whose messages carry the tags of l followed by the given tags, without duplicates.
+// Tags added to or removed from the child do not affect l, nor do later changes to the tags of l affect the child.
+func (l *FileLogger) WithTags(tags ...string) *TaggedLogger {
+	return &TaggedLogger{logger: l, tags: appendTags(l.Tags(), tags...)}
+}
+
+// AddTag adds tag to the tags appended to the messages of the logger, if not already present.
+func (l *FileLogger) AddTag(tag string) {
+	l.tagsMu.Lock()
+	defer l.tagsMu.Unlock()
+
+	l.tags = appendTags(l.tags, tag)
+}
+
+// RemoveTag removes tag from the tags appended to the messages of the logger.
+func (l *FileLogger) RemoveTag(tag string) {
+	l.tagsMu.Lock()
+	defer l.tagsMu.Unlock()
+
+	l.tags = slices.DeleteFunc(slices.Clone(l.tags), func(t string) bool { return t == tag })
+}
+
+// Tags returns a copy of the tags appended to the messages of the logger.
+func (l *FileLogger) Tags() []string {
+	l.tagsMu.RLock()
+	defer l.tagsMu.RUnlock()
+
+	return slices.Clone(l.tags)
+}
+
+func (l *FileLogger) withTags(message string) string {
+	return formatTags(message, l.Tags())
+}
+
+// WithTags returns a child logger with the tags of t followed by the given tags, writing to the same FileLogger.
+func (t *TaggedLogger) WithTags(tags ...string) *TaggedLogger {
+	return &TaggedLogger{logger: t.logger, tags: appendTags(t.Tags(), tags...)}
+}
+
+// AddTag adds tag to the tags of the child logger, if not already present.
+func (t *TaggedLogger) AddTag(tag string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = appendTags(t.tags, tag)
+}
+
+// RemoveTag removes tag from the tags of the child logger.
+func (t *TaggedLogger) RemoveTag(tag string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = slices.DeleteFunc(slices.Clone(t.tags), func(tt string) bool { return tt == tag })
+}
+
+// Tags returns a copy of the tags of the child logger.
+func (t *TaggedLogger) Tags() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return slices.Clone(t.tags)
+}
+
+func (t *TaggedLogger) logAt(level LogLevel, message string) {
+	t.logger.logTaggedAt(level, message, t.Tags())
+}
+
+func (t *TaggedLogger) LogFatal(err error) {
+	t.logAt(LogLevelFatal, err.Error())
+}
+
+func (t *TaggedLogger) LogError(err error) {
+	t.logAt(t.logger.errorLevel(err), err.Error())
+}
+
+func (t *TaggedLogger) LogWarn(message string) {
+	t.logAt(LogLevelWarn, message)
+}
+
+func (t *TaggedLogger) LogInfo(message string) {
+	t.logAt(LogLevelInfo, message)
+}
+
+func (t *TaggedLogger) LogDebug(message string) {
+	t.logAt(LogLevelDebug, message)
+}
+
+func (t *TaggedLogger) LogTrace(message string) {
+	t.logAt(LogLevelTrace, message)
+}
+
+func (t *TaggedLogger) LogBytes(level LogLevel, label string, data []byte) {
+	t.logAt(level, formatBytes(label, data, t.logger.MaxBytesLogged))
+}
+
+func (t *TaggedLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	t.logAt(t.logger.ChangeMinLevel, formatChange(field, from, to, extra))
+}
+
+func (t *TaggedLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	t.logAt(level, formatDiff(label, before, after))
+}
+
+func (t *TaggedLogger) LogFatalf(format string, args ...interface{}) {
+	t.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (t *TaggedLogger) LogErrorf(format string, args ...interface{}) {
+	t.LogError(fmt.Errorf(format, args...))
+}
+
+func (t *TaggedLogger) LogWarnf(format string, args ...interface{}) {
+	t.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (t *TaggedLogger) LogInfof(format string, args ...interface{}) {
+	t.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (t *TaggedLogger) LogDebugf(format string, args ...interface{}) {
+	t.LogDebug(fmt.Sprintf(format, args...))
+}
+
+// formatTags appends `tags=[<tag>,...]` to message, unless tags is empty.
+func formatTags(message string, tags []string) string {
+	if len(tags) == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s tags=[%s]", message, strings.Join(tags, ","))
+}
+
+// appendTags returns a copy of tags with the new tags appended, skipping empty and duplicate ones.
+func appendTags(tags []string, add ...string) []string {
+	merged := slices.Clone(tags)
+	for _, tag := range add {
+		if tag != "" && !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
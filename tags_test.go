@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWithTags(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	child := logger.WithTags("deployment", "europe")
+	grandchild := child.WithTags("v2", "europe")
+
+	logger.LogInfo("parent message")
+	child.LogInfo("child message")
+	grandchild.LogWarn("grandchild message")
+
+	content := readLogFile(t, logger)
+	for _, expected := range []string{
+		"INFO parent message\n",
+		"INFO child message tags=[deployment,europe]\n",
+		"WARNING grandchild message tags=[deployment,europe,v2]\n",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q; got %q", expected, content)
+		}
+	}
+}
+
+func TestAddRemoveTag(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.AddTag("api")
+	logger.AddTag("api")
+	logger.AddTag("eu")
+
+	child := logger.WithTags("beta")
+	child.RemoveTag("api")
+
+	if tags := logger.Tags(); !slices.Equal(tags, []string{"api", "eu"}) {
+		t.Errorf("expected parent tags [api eu]; got %v", tags)
+	}
+	if tags := child.Tags(); !slices.Equal(tags, []string{"eu", "beta"}) {
+		t.Errorf("expected child tags [eu beta]; got %v", tags)
+	}
+
+	logger.RemoveTag("eu")
+	logger.LogInfo("parent message")
+	child.LogInfo("child message")
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "INFO parent message tags=[api]\n") {
+		t.Errorf("expected the remaining parent tag; got %q", content)
+	}
+	if !strings.Contains(content, "INFO child message tags=[eu,beta]\n") {
+		t.Errorf("expected the child tags to be unaffected; got %q", content)
+	}
+}
+
+func TestWithTagsSharesParentState(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	child := logger.WithTags("worker")
+
+	logger.SetMinLevel(LogLevelWarn)
+	logger.SetPrefix("tenant-a ")
+	logger.AddSuppressRule(FieldMatchRule("job", "noisy"))
+	child.LogInfo("dropped")
+	child.LogWarn("suppressed job=noisy")
+	child.LogWarn("kept")
+
+	content := readLogFile(t, logger)
+	if strings.Contains(content, "dropped") || strings.Contains(content, "suppressed") {
+		t.Errorf("expected the child to follow the parent minimum level and suppress rules; got %q", content)
+	}
+	if !strings.Contains(content, "tenant-a WARNING kept tags=[worker]\n") {
+		t.Errorf("expected the child to write with the parent prefix; got %q", content)
+	}
+}
+
+func TestTagsInBlocks(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.AddTag("api")
+
+	group := logger.StartGroup("tx-1")
+	group.LogInfo("grouped")
+	group.Commit()
+	logger.LogMultiline(LogLevelInfo, "query", "SELECT 1")
+	if err := logger.BatchReplay([]LogEntry{{Level: LogLevelWarn, Message: "replayed"}}, false); err != nil {
+		t.Fatalf("failed to replay entries: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	if len(lines) == 0 {
+		t.Fatalf("expected log lines")
+	}
+	for _, line := range lines {
+		if !strings.HasSuffix(line, " tags=[api]") {
+			t.Errorf("expected every line to carry the logger tags; got %q", line)
+		}
+	}
+}
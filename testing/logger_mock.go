@@ -1,14 +1,63 @@
 package testing
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"slices"
+	"time"
+
+	logger "github.com/agusespa/flogg"
+	"github.com/agusespa/flogg/trace"
+)
+
+var _ logger.Logger = (*MockLogger)(nil)
 
 type MockLogger struct {
-	Messages   []string
-	FatalCalls int
-	ErrorCalls int
-	WarnCalls  int
-	InfoCalls  int
-	DebugCalls int
+	Messages             []string
+	FatalCalls           int
+	EmergencyCalls       int
+	ErrorCalls           int
+	WarnCalls            int
+	InfoCalls            int
+	DebugCalls           int
+	TraceCalls           int
+	RequestID            string
+	UserID               string
+	TraceID              string
+	SessionID            string
+	CorrelationID        string
+	Tags                 []string
+	VerifyErr            error
+	BytesDumps           []BytesDump
+	Changes              []ChangeRecord
+	Diffs                []DiffRecord
+	CodedErrors          []CodedError
+	K8sEvents            []K8sEventRecord
+	ProgressCalls        []ProgressRecord
+	Benchmarks           []BenchmarkRecord
+	Traces               []trace.Event
+	DependencyChecks     []logger.DependencyStatus
+	CacheStatsCalls      []CacheStatsRecord
+	Queries              []QueryRecord
+	CircuitBreakerEvents []CircuitBreakerRecord
+	NetworkEvents        []NetworkEventRecord
+	VersionLogs          []VersionRecord
+	GoroutineCounts      []int
+	FileOps              []FileOpRecord
+	ConfigChanges        []ConfigChangeRecord
+	RateLimitEvents      []RateLimitRecord
+	HTTPBodies           []HTTPBodyRecord
+	ConnectionEvents     []ConnectionEventRecord
+	SecurityEvents       []SecurityEventRecord
+	SlowOperations       []SlowOpRecord
+	ReplayedEntries      []logger.LogEntry
+	HTTPErrors           []HTTPErrorRecord
+	DeprecationWarnings  []string
+
+	// messageFields holds the fields passed to the *With methods by index in Messages.
+	messageFields map[int]map[string]interface{}
 }
 
 func (m *MockLogger) LogFatal(err error) {
@@ -16,6 +65,16 @@ func (m *MockLogger) LogFatal(err error) {
 	m.FatalCalls++
 }
 
+func (m *MockLogger) LogEmergency(message string) {
+	m.LogEmergencyWith(message, nil)
+}
+
+func (m *MockLogger) LogEmergencyWith(message string, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("EMERGENCY %s", message))
+	m.recordFields(fields)
+	m.EmergencyCalls++
+}
+
 func (m *MockLogger) LogError(err error) {
 	m.Messages = append(m.Messages, fmt.Sprintf("ERROR %s", err.Error()))
 	m.ErrorCalls++
@@ -35,3 +94,534 @@ func (m *MockLogger) LogDebug(message string) {
 	m.Messages = append(m.Messages, fmt.Sprintf("DEBUG %s", message))
 	m.DebugCalls++
 }
+
+func (m *MockLogger) LogFatalf(format string, args ...interface{}) {
+	m.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (m *MockLogger) LogErrorf(format string, args ...interface{}) {
+	m.LogError(fmt.Errorf(format, args...))
+}
+
+func (m *MockLogger) LogWarnf(format string, args ...interface{}) {
+	m.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (m *MockLogger) LogInfof(format string, args ...interface{}) {
+	m.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (m *MockLogger) LogDebugf(format string, args ...interface{}) {
+	m.LogDebug(fmt.Sprintf(format, args...))
+}
+
+type BytesDump struct {
+	Label string
+	Data  []byte
+}
+
+func (m *MockLogger) LogTrace(message string) {
+	m.Messages = append(m.Messages, fmt.Sprintf("TRACE %s", message))
+	m.TraceCalls++
+}
+
+func (m *MockLogger) LogTraceWith(message string, fields map[string]interface{}) {
+	m.LogTrace(message)
+	m.recordFields(fields)
+}
+
+func (m *MockLogger) LogBytes(level logger.LogLevel, label string, data []byte) {
+	m.Messages = append(m.Messages, fmt.Sprintf("%s %s", level, label))
+	m.BytesDumps = append(m.BytesDumps, BytesDump{Label: label, Data: data})
+}
+
+type ChangeRecord struct {
+	Field string
+	From  interface{}
+	To    interface{}
+	Extra map[string]interface{}
+}
+
+func (m *MockLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("%s CHANGE %s: %v -> %v", logger.LogLevelInfo, field, from, to))
+	m.Changes = append(m.Changes, ChangeRecord{Field: field, From: from, To: to, Extra: extra})
+}
+
+type DiffRecord struct {
+	Label  string
+	Before interface{}
+	After  interface{}
+}
+
+func (m *MockLogger) LogDiff(level logger.LogLevel, label string, before, after interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("%s DIFF %s", level, label))
+	m.Diffs = append(m.Diffs, DiffRecord{Label: label, Before: before, After: after})
+}
+
+type CodedError struct {
+	Code   int
+	Err    error
+	Fields map[string]interface{}
+}
+
+func (m *MockLogger) LogCodedError(code int, err error) {
+	m.LogCodedErrorWith(code, err, nil)
+}
+
+func (m *MockLogger) LogCodedErrorWith(code int, err error, fields map[string]interface{}) {
+	message := fmt.Sprintf("ERROR [%d]", code)
+	if err != nil {
+		message = fmt.Sprintf("%s %s", message, err.Error())
+	}
+	m.Messages = append(m.Messages, message)
+	m.recordFields(fields)
+	m.CodedErrors = append(m.CodedErrors, CodedError{Code: code, Err: err, Fields: fields})
+	m.ErrorCalls++
+}
+
+type K8sEventRecord struct {
+	Reason  string
+	Message string
+	Type    string
+	Fields  map[string]interface{}
+}
+
+func (m *MockLogger) LogKubernetesEvent(reason, message, eventType string, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("K8S_EVENT [%s] %s: %s", eventType, reason, message))
+	m.recordFields(fields)
+	m.K8sEvents = append(m.K8sEvents, K8sEventRecord{Reason: reason, Message: message, Type: eventType, Fields: fields})
+}
+
+type ProgressRecord struct {
+	Current int64
+	Total   int64
+	Message string
+	Fields  map[string]interface{}
+}
+
+func (m *MockLogger) LogProgress(current, total int64, message string, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("PROGRESS %s current=%d total=%d", message, current, total))
+	m.recordFields(fields)
+	m.ProgressCalls = append(m.ProgressCalls, ProgressRecord{Current: current, Total: total, Message: message, Fields: fields})
+}
+
+type BenchmarkRecord struct {
+	Name       string
+	Iterations int
+}
+
+func (m *MockLogger) LogBenchmark(name string, iterations int, fn func()) {
+	for i := 0; i < iterations; i++ {
+		fn()
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("DEBUG benchmark benchmark_name=%s iterations=%d", name, iterations))
+	m.Benchmarks = append(m.Benchmarks, BenchmarkRecord{Name: name, Iterations: iterations})
+}
+
+func (m *MockLogger) LogStructuredTrace(e trace.Event) {
+	m.Messages = append(m.Messages, fmt.Sprintf("INFO trace_event %s", e.Name))
+	m.Traces = append(m.Traces, e)
+}
+
+func (m *MockLogger) LogStartupDependencies(deps []logger.DependencyStatus) {
+	failed := 0
+	for _, dep := range deps {
+		level := logger.LogLevelInfo
+		if dep.Err != nil {
+			failed++
+			level = logger.LogLevelError
+		}
+		m.Messages = append(m.Messages, fmt.Sprintf("%s dependency_check %s status=%s", level, dep.Name, dep.Status))
+	}
+	level := logger.LogLevelInfo
+	if failed > 0 {
+		level = logger.LogLevelError
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("%s all_dependencies_ok=%t count=%d failed=%d", level, failed == 0, len(deps), failed))
+	m.DependencyChecks = append(m.DependencyChecks, deps...)
+}
+
+type CacheStatsRecord struct {
+	Name      string
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Fields    map[string]interface{}
+}
+
+func (m *MockLogger) LogCacheStats(name string, hits, misses, evictions int64, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("DEBUG cache_stats cache_name=%s hits=%d misses=%d evictions=%d", name, hits, misses, evictions))
+	m.recordFields(fields)
+	m.CacheStatsCalls = append(m.CacheStatsCalls, CacheStatsRecord{Name: name, Hits: hits, Misses: misses, Evictions: evictions, Fields: fields})
+}
+
+type QueryRecord struct {
+	Query    string
+	Params   []interface{}
+	Duration time.Duration
+	Rows     int
+	Err      error
+}
+
+func (m *MockLogger) LogQL(query string, params []interface{}, duration time.Duration, rows int, err error) {
+	if err != nil {
+		m.Messages = append(m.Messages, fmt.Sprintf("ERROR db_query query=%q error=%q", query, err.Error()))
+	} else {
+		m.Messages = append(m.Messages, fmt.Sprintf("DEBUG db_query query=%q rows=%d", query, rows))
+	}
+	m.Queries = append(m.Queries, QueryRecord{Query: query, Params: params, Duration: duration, Rows: rows, Err: err})
+}
+
+type CircuitBreakerRecord struct {
+	Name         string
+	State        string
+	PrevState    string
+	FailureCount int
+	Cooldown     time.Duration
+	Fields       map[string]interface{}
+}
+
+func (m *MockLogger) LogCircuitBreaker(name, state, prevState string, failureCount int, cooldown time.Duration, fields map[string]interface{}) {
+	level := "INFO"
+	if state == logger.CircuitOpen {
+		level = "WARNING"
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("%s circuit_breaker name=%s previous_state=%s state=%s", level, name, prevState, state))
+	m.recordFields(fields)
+	m.CircuitBreakerEvents = append(m.CircuitBreakerEvents, CircuitBreakerRecord{
+		Name:         name,
+		State:        state,
+		PrevState:    prevState,
+		FailureCount: failureCount,
+		Cooldown:     cooldown,
+		Fields:       fields,
+	})
+}
+
+type NetworkEventRecord struct {
+	Direction string
+	Protocol  string
+	Src       string
+	Dst       string
+	Bytes     int64
+	Err       error
+	Fields    map[string]interface{}
+}
+
+func (m *MockLogger) LogNetworkEvent(direction, protocol, src, dst string, bytes int64, err error, fields map[string]interface{}) {
+	if err != nil {
+		m.Messages = append(m.Messages, fmt.Sprintf("ERROR network direction=%s protocol=%s src=%s dst=%s error=%q", direction, protocol, src, dst, err.Error()))
+	} else {
+		m.Messages = append(m.Messages, fmt.Sprintf("DEBUG network direction=%s protocol=%s src=%s dst=%s bytes=%d", direction, protocol, src, dst, bytes))
+	}
+	m.recordFields(fields)
+	m.NetworkEvents = append(m.NetworkEvents, NetworkEventRecord{
+		Direction: direction,
+		Protocol:  protocol,
+		Src:       src,
+		Dst:       dst,
+		Bytes:     bytes,
+		Err:       err,
+		Fields:    fields,
+	})
+}
+
+type VersionRecord struct {
+	Version   string
+	Commit    string
+	BuildTime string
+	GoVersion string
+}
+
+func (m *MockLogger) LogAppVersion(version, commit, buildTime, goVersion string) {
+	m.Messages = append(m.Messages, fmt.Sprintf("INFO Starting version=%s commit=%s built=%s go=%s", version, commit, buildTime, goVersion))
+	m.VersionLogs = append(m.VersionLogs, VersionRecord{Version: version, Commit: commit, BuildTime: buildTime, GoVersion: goVersion})
+}
+
+func (m *MockLogger) LogGoroutineCount() {
+	count := runtime.NumGoroutine()
+	m.Messages = append(m.Messages, fmt.Sprintf("DEBUG goroutines count=%d", count))
+	m.GoroutineCounts = append(m.GoroutineCounts, count)
+}
+
+type FileOpRecord struct {
+	Op       string
+	Path     string
+	Bytes    int64
+	Duration time.Duration
+	Err      error
+	Fields   map[string]interface{}
+}
+
+func (m *MockLogger) LogFileOperation(op, path string, bytes int64, duration time.Duration, err error, fields map[string]interface{}) {
+	if err != nil {
+		m.Messages = append(m.Messages, fmt.Sprintf("ERROR file_op op=%s path=%q error=%q", op, path, err.Error()))
+	} else {
+		m.Messages = append(m.Messages, fmt.Sprintf("DEBUG file_op op=%s path=%q bytes=%d duration_ms=%d", op, path, bytes, duration.Milliseconds()))
+	}
+	m.recordFields(fields)
+	m.FileOps = append(m.FileOps, FileOpRecord{
+		Op:       op,
+		Path:     path,
+		Bytes:    bytes,
+		Duration: duration,
+		Err:      err,
+		Fields:   fields,
+	})
+}
+
+type ConfigChangeRecord struct {
+	Key    string
+	OldVal interface{}
+	NewVal interface{}
+	Source string
+	Actor  string
+	Fields map[string]interface{}
+}
+
+func (m *MockLogger) LogConfigChange(key string, oldVal, newVal interface{}, source, actor string, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("INFO config_change key=%s old=%q new=%q source=%s actor=%s", key, fmt.Sprint(oldVal), fmt.Sprint(newVal), source, actor))
+	m.recordFields(fields)
+	m.ConfigChanges = append(m.ConfigChanges, ConfigChangeRecord{
+		Key:    key,
+		OldVal: oldVal,
+		NewVal: newVal,
+		Source: source,
+		Actor:  actor,
+		Fields: fields,
+	})
+}
+
+type RateLimitRecord struct {
+	Resource  string
+	Allowed   bool
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+	Fields    map[string]interface{}
+}
+
+func (m *MockLogger) LogRateLimitEvent(resource string, allowed bool, limit, remaining int, resetAt time.Time, fields map[string]interface{}) {
+	level := "DEBUG"
+	if !allowed {
+		level = "WARNING"
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("%s RATELIMIT %s allowed=%t remaining=%d/%d", level, resource, allowed, remaining, limit))
+	m.recordFields(fields)
+	m.RateLimitEvents = append(m.RateLimitEvents, RateLimitRecord{
+		Resource:  resource,
+		Allowed:   allowed,
+		Limit:     limit,
+		Remaining: remaining,
+		ResetAt:   resetAt,
+		Fields:    fields,
+	})
+}
+
+type HTTPBodyRecord struct {
+	Direction   string
+	ContentType string
+	Body        []byte
+	MaxBytes    int
+	Fields      map[string]interface{}
+}
+
+func (m *MockLogger) LogHTTPBody(direction, contentType string, body []byte, maxBytes int, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("DEBUG http_body direction=%s content_type=%q length=%d", direction, contentType, len(body)))
+	m.recordFields(fields)
+	m.HTTPBodies = append(m.HTTPBodies, HTTPBodyRecord{
+		Direction:   direction,
+		ContentType: contentType,
+		Body:        body,
+		MaxBytes:    maxBytes,
+		Fields:      fields,
+	})
+}
+
+func (m *MockLogger) Verify() error {
+	return m.VerifyErr
+}
+
+type ConnectionEventRecord struct {
+	EventType  string
+	Protocol   string
+	LocalAddr  string
+	RemoteAddr string
+	Err        error
+	Fields     map[string]interface{}
+}
+
+func (m *MockLogger) LogConnectionEvent(eventType, protocol, localAddr, remoteAddr string, err error, fields map[string]interface{}) {
+	if err != nil {
+		m.Messages = append(m.Messages, fmt.Sprintf("ERROR connection event=%s protocol=%s local=%s remote=%s error=%q", eventType, protocol, localAddr, remoteAddr, err.Error()))
+	} else {
+		m.Messages = append(m.Messages, fmt.Sprintf("DEBUG connection event=%s protocol=%s local=%s remote=%s", eventType, protocol, localAddr, remoteAddr))
+	}
+	m.recordFields(fields)
+	m.ConnectionEvents = append(m.ConnectionEvents, ConnectionEventRecord{
+		EventType:  eventType,
+		Protocol:   protocol,
+		LocalAddr:  localAddr,
+		RemoteAddr: remoteAddr,
+		Err:        err,
+		Fields:     fields,
+	})
+}
+
+type SecurityEventRecord struct {
+	EventType string
+	Actor     string
+	Resource  string
+	Outcome   string
+	Fields    map[string]interface{}
+}
+
+func (m *MockLogger) LogSecurityEvent(eventType, actor, resource, outcome string, fields map[string]interface{}) {
+	if outcome != logger.SecurityOutcomeSuccess && outcome != logger.SecurityOutcomeFailure {
+		outcome = logger.SecurityOutcomeUnknown
+	}
+	level := "WARNING"
+	if outcome == logger.SecurityOutcomeSuccess {
+		level = "INFO"
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("%s security event.type=%q user.name=%q file.path=%q event.outcome=%s", level, eventType, actor, resource, outcome))
+	m.recordFields(fields)
+	m.SecurityEvents = append(m.SecurityEvents, SecurityEventRecord{
+		EventType: eventType,
+		Actor:     actor,
+		Resource:  resource,
+		Outcome:   outcome,
+		Fields:    fields,
+	})
+}
+
+type SlowOpRecord struct {
+	Name      string
+	Threshold time.Duration
+	Actual    time.Duration
+	OverBy    time.Duration
+	Fields    map[string]interface{}
+}
+
+func (m *MockLogger) LogSlowOperation(name string, threshold, actual time.Duration, fields map[string]interface{}) {
+	m.Messages = append(m.Messages, fmt.Sprintf("WARNING slow_operation operation=%s threshold_ms=%d actual_ms=%d", name, threshold.Milliseconds(), actual.Milliseconds()))
+	m.recordFields(fields)
+	m.SlowOperations = append(m.SlowOperations, SlowOpRecord{
+		Name:      name,
+		Threshold: threshold,
+		Actual:    actual,
+		OverBy:    actual - threshold,
+		Fields:    fields,
+	})
+}
+
+func (m *MockLogger) BatchReplay(entries []logger.LogEntry, preserveTimestamps bool) error {
+	for _, entry := range entries {
+		m.Messages = append(m.Messages, fmt.Sprintf("%s %s", entry.Level, entry.Message))
+	}
+	m.ReplayedEntries = append(m.ReplayedEntries, entries...)
+	return nil
+}
+
+type HTTPErrorRecord struct {
+	StatusCode int
+	Err        error
+	RequestID  string
+	Fields     map[string]interface{}
+}
+
+func (m *MockLogger) LogHTTPError(w http.ResponseWriter, statusCode int, err error, requestID string, fields map[string]interface{}) {
+	message := http.StatusText(statusCode)
+	if err != nil {
+		message = err.Error()
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("ERROR http_error error=%q request_id=%s status=%d", message, requestID, statusCode))
+	m.recordFields(fields)
+	m.ErrorCalls++
+	m.HTTPErrors = append(m.HTTPErrors, HTTPErrorRecord{
+		StatusCode: statusCode,
+		Err:        err,
+		RequestID:  requestID,
+		Fields:     fields,
+	})
+
+	body, _ := json.Marshal(map[string]interface{}{"error": message, "status": statusCode, "request_id": requestID})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+func (m *MockLogger) WarnDeprecated(feature, replacement, removalVersion string, fields map[string]interface{}) {
+	if slices.Contains(m.DeprecationWarnings, feature) {
+		return
+	}
+	m.Messages = append(m.Messages, fmt.Sprintf("WARNING DEPRECATED %s: use %s (removed in %s)", feature, replacement, removalVersion))
+	m.recordFields(fields)
+	m.WarnCalls++
+	m.DeprecationWarnings = append(m.DeprecationWarnings, feature)
+}
+
+func (m *MockLogger) WithRequestID(id string) *MockLogger {
+	m.RequestID = id
+	return m
+}
+
+func (m *MockLogger) WithUserID(id string) *MockLogger {
+	m.UserID = id
+	return m
+}
+
+func (m *MockLogger) WithCorrelationID(id string) *MockLogger {
+	m.CorrelationID = id
+	return m
+}
+
+func (m *MockLogger) WithTraceID(id string) *MockLogger {
+	m.TraceID = id
+	return m
+}
+
+func (m *MockLogger) WithSessionID(id string) *MockLogger {
+	m.SessionID = id
+	return m
+}
+
+func (m *MockLogger) WithTags(tags ...string) *MockLogger {
+	for _, tag := range tags {
+		m.AddTag(tag)
+	}
+	return m
+}
+
+func (m *MockLogger) AddTag(tag string) {
+	if !slices.Contains(m.Tags, tag) {
+		m.Tags = append(m.Tags, tag)
+	}
+}
+
+func (m *MockLogger) RemoveTag(tag string) {
+	m.Tags = slices.DeleteFunc(m.Tags, func(t string) bool { return t == tag })
+}
+
+// FieldsForMessage returns the fields passed with the last message equal to msg, as recorded in Messages,
+// e.g. "EMERGENCY disk full", by any method taking fields, such as LogEmergencyWith or LogSecurityEvent.
+// It returns nil if the message was not logged or was logged without fields.
+func (m *MockLogger) FieldsForMessage(msg string) map[string]interface{} {
+	for i := len(m.Messages) - 1; i >= 0; i-- {
+		if m.Messages[i] == msg {
+			return m.messageFields[i]
+		}
+	}
+	return nil
+}
+
+// recordFields records fields for the last message appended to Messages.
+func (m *MockLogger) recordFields(fields map[string]interface{}) {
+	if len(fields) == 0 {
+		return
+	}
+	if m.messageFields == nil {
+		m.messageFields = make(map[int]map[string]interface{})
+	}
+	m.messageFields[len(m.Messages)-1] = fields
+}
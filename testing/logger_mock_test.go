@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	logger "github.com/agusespa/flogg"
+)
+
+func TestFieldsForMessage(t *testing.T) {
+	m := &MockLogger{}
+	m.LogEmergencyWith("disk full", logger.NewFields().Int("free_mb", 0).Build())
+	m.LogTraceWith("cache miss", nil)
+	m.LogCodedErrorWith(409, errors.New("conflict"), map[string]interface{}{"resource": "order"})
+	m.LogSecurityEvent("login", "alice", "/admin", logger.SecurityOutcomeSuccess, map[string]interface{}{"ip": "10.0.0.1"})
+	m.LogSlowOperation("export", time.Second, 2*time.Second, map[string]interface{}{"rows": 10})
+	m.LogHTTPError(httptest.NewRecorder(), 404, nil, "req-1", map[string]interface{}{"path": "/missing"})
+	m.LogEmergencyWith("disk full", map[string]interface{}{"free_mb": 1})
+
+	tests := []struct {
+		name     string
+		message  string
+		expected map[string]interface{}
+	}{
+		{name: "latest of repeated message", message: "EMERGENCY disk full", expected: map[string]interface{}{"free_mb": 1}},
+		{name: "without fields", message: "TRACE cache miss", expected: nil},
+		{name: "coded error", message: "ERROR [409] conflict", expected: map[string]interface{}{"resource": "order"}},
+		{name: "security event", message: m.Messages[3], expected: map[string]interface{}{"ip": "10.0.0.1"}},
+		{name: "slow operation", message: m.Messages[4], expected: map[string]interface{}{"rows": 10}},
+		{name: "http error", message: m.Messages[5], expected: map[string]interface{}{"path": "/missing"}},
+		{name: "not logged", message: "INFO never", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.FieldsForMessage(tt.message); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v; got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMessageLevels(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      func(m *MockLogger)
+		expected []string
+	}{
+		{
+			name: "startup dependencies",
+			log: func(m *MockLogger) {
+				m.LogStartupDependencies([]logger.DependencyStatus{
+					{Name: "db", Status: "up"},
+					{Name: "cache", Status: "down", Err: errors.New("refused")},
+				})
+			},
+			expected: []string{
+				"INFO dependency_check db status=up",
+				"ERROR dependency_check cache status=down",
+				"ERROR all_dependencies_ok=false count=2 failed=1",
+			},
+		},
+		{
+			name:     "change",
+			log:      func(m *MockLogger) { m.LogChange("max_conns", 10, 20, nil) },
+			expected: []string{"INFO CHANGE max_conns: 10 -> 20"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MockLogger{}
+			tt.log(m)
+			if !reflect.DeepEqual(m.Messages, tt.expected) {
+				t.Errorf("expected %q; got %q", tt.expected, m.Messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	logger "github.com/agusespa/flogg"
+)
+
+// DeferCleanup closes l when the test t and its subtests complete and logs the log directory to the test output.
+//
+//	func TestHandler(t *testing.T) {
+//		l := logger.NewLogger(false, "myapp-test")
+//		flogtesting.DeferCleanup(t, l)
+//		...
+//	}
+func DeferCleanup(t testing.TB, l *logger.FileLogger) {
+	t.Helper()
+	t.Logf("logging to %s", l.LogDir)
+	t.Cleanup(func() {
+		l.Close()
+	})
+}
+
+// NewLoggerForTest creates a FileLogger writing to a temporary directory of t, which is closed and removed when the test completes.
+//
+//	func TestHandler(t *testing.T) {
+//		l, err := flogtesting.NewLoggerForTest(t)
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		...
+//	}
+func NewLoggerForTest(t testing.TB, opts ...logger.Option) (*logger.FileLogger, error) {
+	t.Helper()
+	l, err := logger.NewLoggerWithPath(false, t.TempDir(), logger.LogLevelDebug, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	DeferCleanup(t, l)
+	return l, nil
+}
+
+// ExpectLog snapshots the log file of l and returns a function that asserts, through t.Errorf,
+// that an entry at level containing msgSubstr was written since ExpectLog was called.
+//
+//	check := flogtesting.ExpectLog(t, l, logger.LogLevelWarn, "retrying")
+//	client.Do(req)
+//	check()
+//
+// The log file must not be encrypted.
+func ExpectLog(t testing.TB, l *logger.FileLogger, level logger.LogLevel, msgSubstr string) func() {
+	t.Helper()
+	entries := entriesSince(t, l)
+	return func() {
+		t.Helper()
+		if !containsEntry(entries(), level, msgSubstr) {
+			t.Errorf("expected a %s log entry containing %q", level, msgSubstr)
+		}
+	}
+}
+
+// ExpectNoLog is the negative of ExpectLog: the returned function fails the test
+// if an entry at level containing msgSubstr was written since ExpectNoLog was called.
+func ExpectNoLog(t testing.TB, l *logger.FileLogger, level logger.LogLevel, msgSubstr string) func() {
+	t.Helper()
+	entries := entriesSince(t, l)
+	return func() {
+		t.Helper()
+		if containsEntry(entries(), level, msgSubstr) {
+			t.Errorf("expected no %s log entry containing %q", level, msgSubstr)
+		}
+	}
+}
+
+// entriesSince records the current end of the log file and returns a function reading the entries written after it.
+func entriesSince(t testing.TB, l *logger.FileLogger) func() []logger.LogEntry {
+	t.Helper()
+	cursor, err := l.Cursor()
+	if err != nil {
+		t.Fatalf("failed to snapshot log file: %s", err)
+	}
+
+	return func() []logger.LogEntry {
+		t.Helper()
+		entries, err := cursor.Entries()
+		if err != nil {
+			t.Fatalf("failed to read log file: %s", err)
+		}
+		return entries
+	}
+}
+
+func containsEntry(entries []logger.LogEntry, level logger.LogLevel, msgSubstr string) bool {
+	for _, entry := range entries {
+		if entry.Level == level && strings.Contains(entry.Message, msgSubstr) {
+			return true
+		}
+	}
+	return false
+}
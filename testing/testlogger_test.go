@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"testing"
+
+	logger "github.com/agusespa/flogg"
+)
+
+// discardConsole silences the console output of the loggers created by the test.
+func discardConsole(t *testing.T) {
+	output := log.Writer()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(output) })
+}
+
+func TestNewLoggerForTest(t *testing.T) {
+	discardConsole(t)
+
+	var l *logger.FileLogger
+	t.Run("create", func(t *testing.T) {
+		var err error
+		l, err = NewLoggerForTest(t)
+		if err != nil {
+			t.Fatalf("failed to create logger: %s", err)
+		}
+		if filepath.Dir(l.LogDir) != filepath.Dir(t.TempDir()) {
+			t.Errorf("expected the log directory under the test temp directory; got %s", l.LogDir)
+		}
+		l.LogDebug("inside the test")
+	})
+
+	if err := l.Verify(); err == nil {
+		t.Errorf("expected the logger to be closed when the test completed")
+	}
+}
+
+// recordingT records the failures reported through it instead of failing the test.
+type recordingT struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestExpectLog(t *testing.T) {
+	discardConsole(t)
+
+	tests := []struct {
+		name     string
+		level    logger.LogLevel
+		substr   string
+		negative bool
+		failed   bool
+	}{
+		{name: "matching entry", level: logger.LogLevelWarn, substr: "retrying"},
+		{name: "wrong level", level: logger.LogLevelError, substr: "retrying", failed: true},
+		{name: "entry before the snapshot", level: logger.LogLevelInfo, substr: "before", failed: true},
+		{name: "no matching message", level: logger.LogLevelWarn, substr: "giving up", failed: true},
+		{name: "negative without entry", level: logger.LogLevelError, substr: "retrying", negative: true},
+		{name: "negative with entry", level: logger.LogLevelWarn, substr: "retrying", negative: true, failed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLoggerForTest(t)
+			if err != nil {
+				t.Fatalf("failed to create logger: %s", err)
+			}
+			l.LogInfo("before the snapshot")
+
+			rt := &recordingT{TB: t}
+			var check func()
+			if tt.negative {
+				check = ExpectNoLog(rt, l, tt.level, tt.substr)
+			} else {
+				check = ExpectLog(rt, l, tt.level, tt.substr)
+			}
+
+			l.LogWarn("retrying request attempt=2")
+			check()
+
+			if failed := len(rt.failures) > 0; failed != tt.failed {
+				t.Errorf("expected failure %t; got %q", tt.failed, rt.failures)
+			}
+		})
+	}
+}
+
+func TestExpectLogNoWrites(t *testing.T) {
+	discardConsole(t)
+	l, err := NewLoggerForTest(t)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	l.LogWarn("retrying")
+
+	rt := &recordingT{TB: t}
+	expect := ExpectLog(rt, l, logger.LogLevelWarn, "retrying")
+	expectNo := ExpectNoLog(rt, l, logger.LogLevelWarn, "retrying")
+	expect()
+	if len(rt.failures) != 1 {
+		t.Errorf("expected ExpectLog to fail without writes; got %q", rt.failures)
+	}
+	expectNo()
+	if len(rt.failures) != 1 {
+		t.Errorf("expected ExpectNoLog to pass without writes; got %q", rt.failures)
+	}
+}
+
+func TestExpectLogHostname(t *testing.T) {
+	discardConsole(t)
+	l, err := NewLoggerForTest(t, logger.WithHostname(true))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+
+	check := ExpectLog(t, l, logger.LogLevelInfo, "request served")
+	l.LogInfo("request served")
+	check()
+}
@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// throttleState holds the time of the last write, in Unix nanoseconds, for each throttle key of each logger.
+var throttleState sync.Map // map[throttleKey]*atomic.Int64
+
+type throttleKey struct {
+	logger *FileLogger
+	key    string
+}
+
+type throttledLogger struct {
+	logger   *FileLogger
+	last     *atomic.Int64
+	interval time.Duration
+}
+
+// Throttle returns a logger that drops any write made within interval of the previous write with the same key.
+// Throttled loggers created from the same FileLogger with the same key share their state, while other loggers
+// using the same key are not affected. Fatal errors are never dropped.
+func (l *FileLogger) Throttle(key string, interval time.Duration) Logger {
+	last, _ := throttleState.LoadOrStore(throttleKey{logger: l, key: key}, new(atomic.Int64))
+	return &throttledLogger{logger: l, last: last.(*atomic.Int64), interval: interval}
+}
+
+// logAt writes message at level unless the previous write is within the interval. Messages dropped by the minimum
+// level or a suppress rule do not count as writes, so they do not start a new interval.
+func (t *throttledLogger) logAt(level LogLevel, message string) {
+	if !t.logger.enabled(level) || t.logger.suppress(level, message) {
+		return
+	}
+	if t.allow() {
+		t.logger.writeAt(level, message)
+	}
+}
+
+// allow reports whether a write may go through, recording it as the last write if so.
+func (t *throttledLogger) allow() bool {
+	now := time.Now().UnixNano()
+	for {
+		last := t.last.Load()
+		if last != 0 && now-last < int64(t.interval) {
+			t.logger.throttled.Add(1)
+			return false
+		}
+		if t.last.CompareAndSwap(last, now) {
+			return true
+		}
+	}
+}
+
+func (t *throttledLogger) LogFatal(err error) {
+	t.logger.LogFatal(err)
+}
+
+func (t *throttledLogger) LogError(err error) {
+	t.logAt(t.logger.errorLevel(err), err.Error())
+}
+
+func (t *throttledLogger) LogWarn(message string) {
+	t.logAt(LogLevelWarn, message)
+}
+
+func (t *throttledLogger) LogInfo(message string) {
+	t.logAt(LogLevelInfo, message)
+}
+
+func (t *throttledLogger) LogDebug(message string) {
+	t.logAt(LogLevelDebug, message)
+}
+
+func (t *throttledLogger) LogTrace(message string) {
+	t.logAt(LogLevelTrace, message)
+}
+
+func (t *throttledLogger) LogBytes(level LogLevel, label string, data []byte) {
+	t.logAt(level, formatBytes(label, data, t.logger.MaxBytesLogged))
+}
+
+func (t *throttledLogger) LogChange(field string, from, to interface{}, extra map[string]interface{}) {
+	t.logAt(t.logger.ChangeMinLevel, formatChange(field, from, to, extra))
+}
+
+func (t *throttledLogger) LogDiff(level LogLevel, label string, before, after interface{}) {
+	t.logAt(level, formatDiff(label, before, after))
+}
+
+func (t *throttledLogger) LogFatalf(format string, args ...interface{}) {
+	t.LogFatal(fmt.Errorf(format, args...))
+}
+
+func (t *throttledLogger) LogErrorf(format string, args ...interface{}) {
+	t.LogError(fmt.Errorf(format, args...))
+}
+
+func (t *throttledLogger) LogWarnf(format string, args ...interface{}) {
+	t.LogWarn(fmt.Sprintf(format, args...))
+}
+
+func (t *throttledLogger) LogInfof(format string, args ...interface{}) {
+	t.LogInfo(fmt.Sprintf(format, args...))
+}
+
+func (t *throttledLogger) LogDebugf(format string, args ...interface{}) {
+	t.LogDebug(fmt.Sprintf(format, args...))
+}
+
+// releaseThrottleState drops the throttle state of l so that closed loggers can be garbage collected.
+func releaseThrottleState(l *FileLogger) {
+	throttleState.Range(func(k, _ interface{}) bool {
+		if k.(throttleKey).logger == l {
+			throttleState.Delete(k)
+		}
+		return true
+	})
+}
@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	throttled := logger.Throttle("poll", time.Hour)
+	throttled.LogInfo("first")
+	throttled.LogInfo("second")
+	logger.Throttle("poll", time.Hour).LogWarn("third")
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "INFO first") {
+		t.Errorf("expected the first write to pass; got %q", content)
+	}
+	if strings.Contains(content, "second") || strings.Contains(content, "third") {
+		t.Errorf("expected writes within the interval to be suppressed; got %q", content)
+	}
+	if throttledMessages := logger.Stats().ThrottledMessages; throttledMessages != 2 {
+		t.Errorf("expected 2 throttled messages; got %d", throttledMessages)
+	}
+}
+
+func TestThrottleInterval(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	throttled := logger.Throttle("poll", 10*time.Millisecond)
+	throttled.LogInfo("first")
+	time.Sleep(20 * time.Millisecond)
+	throttled.LogInfo("second")
+
+	if content := readLogFile(t, logger); !strings.Contains(content, "INFO second") {
+		t.Errorf("expected a write after the interval to pass; got %q", content)
+	}
+}
+
+func TestThrottleIsolation(t *testing.T) {
+	captureConsole(t)
+	first := newTestLogger(t)
+	second := newTestLogger(t)
+
+	first.Throttle("poll", time.Hour).LogInfo("first logger")
+	second.Throttle("poll", time.Hour).LogInfo("second logger")
+	first.Throttle("other", time.Hour).LogInfo("other key")
+
+	if content := readLogFile(t, second); !strings.Contains(content, "INFO second logger") {
+		t.Errorf("expected loggers not to share throttle state; got %q", content)
+	}
+	if content := readLogFile(t, first); !strings.Contains(content, "INFO other key") {
+		t.Errorf("expected keys not to share throttle state; got %q", content)
+	}
+}
+
+func TestThrottleFilteredLevel(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelInfo))
+
+	throttled := logger.Throttle("poll", time.Hour)
+	throttled.LogDebug("filtered")
+	throttled.LogInfo("kept")
+
+	content := readLogFile(t, logger)
+	if strings.Contains(content, "filtered") || !strings.Contains(content, "INFO kept") {
+		t.Errorf("expected a message below the minimum level not to start the interval; got %q", content)
+	}
+	if throttledMessages := logger.Stats().ThrottledMessages; throttledMessages != 0 {
+		t.Errorf("expected no throttled messages; got %d", throttledMessages)
+	}
+}
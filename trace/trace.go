@@ -0,0 +1,22 @@
+// Package trace defines the trace events written by FileLogger.LogStructuredTrace.
+package trace
+
+import "time"
+
+// Event is a finished span of a distributed trace, following the OpenTelemetry span model.
+type Event struct {
+	Name          string
+	SpanID        string
+	TraceID       string
+	ParentSpanID  string
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    map[string]interface{}
+	Status        string
+	StatusMessage string
+}
+
+// Duration returns the time between the start and the end of the span.
+func (e Event) Duration() time.Duration {
+	return e.EndTime.Sub(e.StartTime)
+}
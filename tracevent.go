@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/agusespa/flogg/trace"
+)
+
+// LogStructuredTrace logs a finished span of a distributed trace at INFO level,
+// as `trace_event <name> trace_id=<id> span_id=<id> start=<time> duration_ms=<ms> [fields]`.
+// The parent_span_id, status and status_message fields are only written when set, and the span attributes are
+// appended as key=value pairs; the event fields take precedence over attributes with the same key.
+func (l *FileLogger) LogStructuredTrace(e trace.Event) {
+	l.logAt(LogLevelInfo, formatTraceEvent(e))
+}
+
+func formatTraceEvent(e trace.Event) string {
+	fields := make(map[string]interface{}, len(e.Attributes)+7)
+	for k, v := range e.Attributes {
+		fields[k] = v
+	}
+	fields["trace_id"] = e.TraceID
+	fields["span_id"] = e.SpanID
+	fields["start"] = e.StartTime.Format(time.RFC3339Nano)
+	fields["duration_ms"] = e.Duration().Milliseconds()
+	optional := map[string]string{
+		"parent_span_id": e.ParentSpanID,
+		"status":         e.Status,
+		"status_message": e.StatusMessage,
+	}
+	for k, v := range optional {
+		if v != "" {
+			fields[k] = v
+		}
+	}
+
+	return fmt.Sprintf("trace_event %s %s", e.Name, formatFields(fields))
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agusespa/flogg/trace"
+)
+
+func TestFormatTraceEvent(t *testing.T) {
+	start := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		event    trace.Event
+		expected string
+	}{
+		{
+			name: "root span",
+			event: trace.Event{
+				Name:      "GET /users",
+				TraceID:   "4bf92f3577b34da6",
+				SpanID:    "00f067aa0ba902b7",
+				StartTime: start,
+				EndTime:   start.Add(120 * time.Millisecond),
+			},
+			expected: "trace_event GET /users duration_ms=120 span_id=00f067aa0ba902b7 start=2025-01-02T15:04:05Z trace_id=4bf92f3577b34da6",
+		},
+		{
+			name: "child span with status and attributes",
+			event: trace.Event{
+				Name:          "db.query",
+				TraceID:       "4bf92f3577b34da6",
+				SpanID:        "b7ad6b7169203331",
+				ParentSpanID:  "00f067aa0ba902b7",
+				StartTime:     start,
+				EndTime:       start.Add(80 * time.Millisecond),
+				Attributes:    map[string]interface{}{"db.system": "postgresql", "span_id": "ignored"},
+				Status:        "ERROR",
+				StatusMessage: "timeout",
+			},
+			expected: "trace_event db.query db.system=postgresql duration_ms=80 parent_span_id=00f067aa0ba902b7 span_id=b7ad6b7169203331 start=2025-01-02T15:04:05Z status=ERROR status_message=timeout trace_id=4bf92f3577b34da6",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := formatTraceEvent(tt.event)
+			if actual != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLogStructuredTrace(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	start := time.Now()
+	logger.LogStructuredTrace(trace.Event{Name: "checkout", TraceID: "t1", SpanID: "s1", StartTime: start, EndTime: start})
+
+	if content := readLogFile(t, logger); !strings.Contains(content, "INFO trace_event checkout duration_ms=0") {
+		t.Errorf("expected a trace event line; got %q", content)
+	}
+}
@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	verifyProbeLabel = "_verify_probe"
+	// verifyTailBytes is how much of the end of a plain log file Verify reads back looking for its probe.
+	verifyTailBytes = 64 << 10
+)
+
+// Verify checks that the logger can write by appending a `_verify_probe id=<random id>` line at DEBUG level to the
+// current log file and reading it back, e.g. in a readiness probe. The probe line is written regardless of the
+// minimum level and is not sent to the console or the named outputs. It returns an error if the logger is closed,
+// writes to a writer other than a file, or the probe cannot be found.
+func (l *FileLogger) Verify() error {
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Errorf("failed generating probe id: %w", err)
+	}
+	probe := fmt.Sprintf("%s id=%s", verifyProbeLabel, hex.EncodeToString(id))
+
+	path, err := l.writeProbe(fmt.Sprintf("%s %s", LogLevelDebug, probe))
+	if err != nil {
+		return err
+	}
+
+	content, err := l.readLogTail(path)
+	if err != nil {
+		return fmt.Errorf("failed reading back log file: %w", err)
+	}
+	if !bytes.Contains(content, []byte(probe)) {
+		return fmt.Errorf("probe not found in %s", path)
+	}
+	return nil
+}
+
+// writeProbe writes line to the current log file, without refreshing it, and returns the path of the file.
+func (l *FileLogger) writeProbe(line string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return "", errors.New("logger is closed")
+	}
+	if l.CurrentLogFile == nil {
+		return "", errors.New("logger does not write to a file")
+	}
+	if l.dirLock != nil {
+		l.dirLock.mu.Lock()
+		defer l.dirLock.mu.Unlock()
+	}
+
+	if _, err := l.CurrentLogFile.Stat(); err != nil {
+		return "", fmt.Errorf("failed checking log file: %w", err)
+	}
+	if err := l.FileLog.Output(1, line); err != nil {
+		return "", fmt.Errorf("failed writing probe: %w", err)
+	}
+	return l.CurrentLogFile.Name(), nil
+}
+
+// readLogTail returns the end of the log file at path, or all of it, decrypted or decoded into UTF-8, if the log
+// files are encrypted or not UTF-8 encoded.
+func (l *FileLogger) readLogTail(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if len(l.EncryptionKey) > 0 {
+		r, err := NewDecryptingReader(f, l.EncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+	if !isUTF8(l.TextEncoding) {
+		r, err := newDecodingReader(f, l.TextEncoding)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if offset := info.Size() - verifyTailBytes; offset > 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	return io.ReadAll(f)
+}
@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "plain"},
+		{name: "min level above debug", opts: []Option{WithMinLevel(LogLevelError)}},
+		{name: "encrypted", opts: []Option{WithEncryptionKey(bytes.Repeat([]byte{3}, 32))}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t, tt.opts...)
+
+			for i := 0; i < 10; i++ {
+				logger.LogError(errors.New("line"))
+			}
+			if err := logger.Verify(); err != nil {
+				t.Errorf("expected Verify to succeed; got %s", err)
+			}
+		})
+	}
+}
+
+func TestVerifyProbeLine(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	if err := logger.Verify(); err != nil {
+		t.Fatalf("expected Verify to succeed; got %s", err)
+	}
+	if content := readLogFile(t, logger); !strings.Contains(content, "DEBUG _verify_probe id=") {
+		t.Errorf("expected the probe line; got %q", content)
+	}
+}
+
+func TestVerifyBrokenFile(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.LogInfo("before")
+
+	logger.CurrentLogFile.Close()
+	if err := logger.Verify(); err == nil {
+		t.Errorf("expected an error with a broken file handle")
+	}
+}
+
+func TestVerifyClosed(t *testing.T) {
+	logger := newTestLogger(t)
+	logger.Close()
+
+	if err := logger.Verify(); err == nil {
+		t.Errorf("expected an error after Close")
+	}
+}
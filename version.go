@@ -0,0 +1,24 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// LogAppVersion logs the build information of the application at INFO level, usually right after startup, as
+// `Starting <app> version=1.2.3 commit=abc1234 built=2025-01-01T00:00:00Z go=go1.22 os=linux arch=amd64`,
+// where app is the name of the executable and os and arch come from the runtime. An empty goVersion is
+// replaced by runtime.Version().
+func (l *FileLogger) LogAppVersion(version, commit, buildTime, goVersion string) {
+	l.logAt(LogLevelInfo, formatAppVersion(filepath.Base(os.Args[0]), version, commit, buildTime, goVersion))
+}
+
+func formatAppVersion(app, version, commit, buildTime, goVersion string) string {
+	if goVersion == "" {
+		goVersion = runtime.Version()
+	}
+	return fmt.Sprintf("Starting %s version=%s commit=%s built=%s go=%s os=%s arch=%s",
+		app, version, commit, buildTime, goVersion, runtime.GOOS, runtime.GOARCH)
+}
@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFormatAppVersion(t *testing.T) {
+	platform := fmt.Sprintf("os=%s arch=%s", runtime.GOOS, runtime.GOARCH)
+	tests := []struct {
+		name      string
+		goVersion string
+		expected  string
+	}{
+		{
+			name:      "explicit go version",
+			goVersion: "go1.22",
+			expected:  "Starting myapp version=1.2.3 commit=abc1234 built=2025-01-01T00:00:00Z go=go1.22 " + platform,
+		},
+		{
+			name:     "runtime go version",
+			expected: "Starting myapp version=1.2.3 commit=abc1234 built=2025-01-01T00:00:00Z go=" + runtime.Version() + " " + platform,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatAppVersion("myapp", "1.2.3", "abc1234", "2025-01-01T00:00:00Z", tt.goVersion)
+			if got != tt.expected {
+				t.Errorf("expected %q; got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLogAppVersion(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMinLevel(LogLevelInfo))
+
+	logger.LogAppVersion("1.2.3", "abc1234", "2025-01-01T00:00:00Z", "go1.22")
+
+	content := readLogFile(t, logger)
+	if !strings.Contains(content, "INFO Starting ") || !strings.Contains(content, " version=1.2.3 commit=abc1234 ") {
+		t.Errorf("expected the version line; got %q", content)
+	}
+}
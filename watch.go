@@ -0,0 +1,242 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const logTimeLayout = "2006/01/02 15:04:05"
+
+// LogEntry is an entry read back from a log file.
+type LogEntry struct {
+	Time  time.Time
+	Level LogLevel
+	// Message is the text after the level token; the continuation lines of multiline entries are joined with "\n".
+	Message string
+	// Raw is the entry as written to the file, without the trailing newline.
+	Raw string
+}
+
+// WatchFile polls the current log file every pollInterval and sends the entries written since the previous poll on
+// the returned channel, starting with the entries written after the call. When the logger rotates, the rest of the
+// previous file, and of any file rotated in between, is read before switching to the new one. An entry is sent once
+// the next one starts or a poll finds nothing new, since more lines of a multiline entry may follow. The channel is
+// closed when ctx is done or the logger is closed. Encrypted or UTF-16 log files and non-file writers set by
+// SetPrimaryOutput cannot be watched.
+func (l *FileLogger) WatchFile(ctx context.Context, pollInterval time.Duration) (<-chan []LogEntry, error) {
+	if len(l.EncryptionKey) > 0 {
+		return nil, errors.New("encrypted log files cannot be watched")
+	}
+	if !isUTF8(l.TextEncoding) {
+		return nil, errors.New("UTF-16 log files cannot be watched")
+	}
+
+	path := l.currentLogPath()
+	if path == "" {
+		return nil, errors.New("logger does not write to a file")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(chan []LogEntry)
+	w := &fileWatcher{logger: l, path: path, offset: info.Size()}
+	l.goBackground(func(done <-chan struct{}) {
+		defer close(entries)
+		defer w.close()
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			batch := w.poll()
+			if len(batch) == 0 {
+				continue
+			}
+			select {
+			case entries <- batch:
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			}
+		}
+	})
+	return entries, nil
+}
+
+// currentLogPath returns the path of the current log file, or "" if the logger does not write to a file.
+func (l *FileLogger) currentLogPath() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.CurrentLogFile == nil {
+		return ""
+	}
+	return l.CurrentLogFile.Name()
+}
+
+// fileWatcher reads the complete lines appended to the current log file since the previous poll.
+type fileWatcher struct {
+	logger  *FileLogger
+	path    string
+	file    *os.File
+	offset  int64
+	partial string
+	pending *LogEntry
+}
+
+func (w *fileWatcher) poll() []LogEntry {
+	var lines []string
+	current := w.logger.currentLogPath()
+	for current != "" && w.path != current {
+		lines = append(lines, w.readLines()...)
+		w.close()
+		next := nextLogFilePath(w.path, w.logger.SeqPadding)
+		if next == "" {
+			next = current
+		}
+		w.path, w.offset, w.partial = next, 0, ""
+	}
+	if w.replaced() {
+		lines = append(lines, w.readLines()...)
+		w.close()
+		w.offset, w.partial = 0, ""
+	}
+	lines = append(lines, w.readLines()...)
+
+	var batch []LogEntry
+	prefix := w.logger.linePrefixLocked()
+	for _, line := range lines {
+		entry, ok := parseLogLine(line, prefix)
+		if !ok {
+			if w.pending != nil {
+				w.pending.Message += "\n" + line
+				w.pending.Raw += "\n" + line
+			}
+			continue
+		}
+		if w.pending != nil {
+			batch = append(batch, *w.pending)
+		}
+		w.pending = &entry
+	}
+	// The last entry is held back until the next one starts, since more lines of a multiline entry may follow,
+	// unless nothing else was written.
+	if len(lines) == 0 && w.pending != nil {
+		batch = append(batch, *w.pending)
+		w.pending = nil
+	}
+	return batch
+}
+
+// readLines reads the complete lines appended to the file since the previous read.
+func (w *fileWatcher) readLines() []string {
+	if w.file == nil {
+		file, err := os.Open(w.path)
+		if err != nil {
+			return nil
+		}
+		w.file = file
+	}
+
+	info, err := w.file.Stat()
+	if err != nil || info.Size() <= w.offset {
+		return nil
+	}
+	buf := make([]byte, info.Size()-w.offset)
+	n, err := w.file.ReadAt(buf, w.offset)
+	if err != nil && err != io.EOF {
+		return nil
+	}
+	w.offset += int64(n)
+
+	content := w.partial + string(buf[:n])
+	end := strings.LastIndexByte(content, '\n')
+	if end < 0 {
+		w.partial = content
+		return nil
+	}
+	w.partial = content[end+1:]
+	return strings.Split(content[:end], "\n")
+}
+
+// replaced reports whether the open file is no longer the one at w.path, e.g. after CompressCurrentFile moved it away
+// and created a new file in its place.
+func (w *fileWatcher) replaced() bool {
+	if w.file == nil {
+		return false
+	}
+	current, err := os.Stat(w.path)
+	if err != nil {
+		return false
+	}
+	opened, err := w.file.Stat()
+	return err != nil || !os.SameFile(opened, current)
+}
+
+func (w *fileWatcher) close() {
+	if w.file != nil {
+		w.file.Close()
+		w.file = nil
+	}
+}
+
+// nextLogFilePath returns the path of the log file following the one at path in the same day, if it exists,
+// so that the files rotated between two polls are not skipped.
+func nextLogFilePath(path string, seqPadding int) string {
+	filename := filepath.Base(path)
+	date := logFileNamePrefix(filename)
+	num, ok := parseLogFileSeq(filename, date, seqPadding)
+	if !ok {
+		return ""
+	}
+
+	next := filepath.Join(filepath.Dir(path), formatLogFileName(date, num+1, seqPadding))
+	if _, err := os.Stat(next); err != nil {
+		return ""
+	}
+	return next
+}
+
+// linePrefixLocked returns the line prefix, taking the logger lock.
+func (l *FileLogger) linePrefixLocked() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.linePrefix()
+}
+
+// parseLogLine parses a line starting a log file entry, written with the timestamp, the line prefix and a level token.
+// It reports false for lines that do not start an entry, such as continuation lines of multiline messages.
+func parseLogLine(line, prefix string) (LogEntry, bool) {
+	if len(line) <= len(logTimeLayout) {
+		return LogEntry{}, false
+	}
+	t, err := time.ParseInLocation(logTimeLayout, line[:len(logTimeLayout)], time.Local)
+	if err != nil {
+		return LogEntry{}, false
+	}
+
+	rest := strings.TrimPrefix(line[len(logTimeLayout)+1:], prefix)
+	token, message, _ := strings.Cut(rest, " ")
+	var level LogLevel
+	if err := level.UnmarshalText([]byte(token)); err != nil {
+		return LogEntry{}, false
+	}
+	return LogEntry{Time: t, Level: level, Message: message, Raw: line}, true
+}
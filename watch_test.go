@@ -0,0 +1,157 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWatchFile(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	logger.LogInfo("before watching")
+
+	const interval = 20 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, err := logger.WatchFile(ctx, interval)
+	if err != nil {
+		t.Fatalf("failed to watch file: %s", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		logger.LogWarn(fmt.Sprintf("line %d", i))
+	}
+
+	var received []LogEntry
+	timeout := time.After(5 * interval)
+	for len(received) < 10 {
+		select {
+		case batch := <-entries:
+			received = append(received, batch...)
+		case <-timeout:
+			t.Fatalf("expected 10 entries within 5 intervals; got %d", len(received))
+		}
+	}
+
+	for i, entry := range received {
+		if entry.Level != LogLevelWarn || entry.Message != fmt.Sprintf("line %d", i) {
+			t.Errorf("expected WARNING line %d; got %s %q", i, entry.Level, entry.Message)
+		}
+		if time.Since(entry.Time) > time.Minute {
+			t.Errorf("expected a recent entry time; got %s", entry.Time)
+		}
+	}
+}
+
+func TestWatchFileMultiline(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithPrefix("[svc] "))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, err := logger.WatchFile(ctx, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch file: %s", err)
+	}
+
+	logger.LogInfo("first\nsecond")
+	select {
+	case batch := <-entries:
+		if len(batch) != 1 || batch[0].Message != "first\nsecond" {
+			t.Errorf("expected one entry with both lines; got %+v", batch)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected an entry")
+	}
+}
+
+func TestWatchFileRotation(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t, WithMaxLinesPerFile(3))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, err := logger.WatchFile(ctx, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch file: %s", err)
+	}
+
+	for i := 0; i < 8; i++ {
+		logger.LogInfo(fmt.Sprintf("line %d", i))
+	}
+
+	var received []LogEntry
+	timeout := time.After(time.Second)
+	for len(received) < 8 {
+		select {
+		case batch := <-entries:
+			received = append(received, batch...)
+		case <-timeout:
+			t.Fatalf("expected 8 entries across rotations; got %d", len(received))
+		}
+	}
+	for i, entry := range received {
+		if entry.Message != fmt.Sprintf("line %d", i) {
+			t.Errorf("expected line %d; got %q", i, entry.Message)
+		}
+	}
+}
+
+func TestWatchFileAfterCompress(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entries, err := logger.WatchFile(ctx, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch file: %s", err)
+	}
+
+	var received []LogEntry
+	receive := func(n int) {
+		timeout := time.After(time.Second)
+		for len(received) < n {
+			select {
+			case batch := <-entries:
+				received = append(received, batch...)
+			case <-timeout:
+				t.Fatalf("expected %d entries; got %+v", n, received)
+			}
+		}
+	}
+
+	logger.LogInfo("before compressing")
+	receive(1)
+	if err := logger.CompressCurrentFile(); err != nil {
+		t.Fatalf("failed to compress log file: %s", err)
+	}
+	logger.LogInfo("after compressing")
+	receive(2)
+
+	if received[1].Message != "after compressing" {
+		t.Errorf("expected the entry written to the new file; got %q", received[1].Message)
+	}
+}
+
+func TestWatchFileCancel(t *testing.T) {
+	logger := newTestLogger(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	entries, err := logger.WatchFile(ctx, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch file: %s", err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-entries:
+		if ok {
+			t.Errorf("expected no entries after cancelling")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the channel to be closed")
+	}
+}
@@ -0,0 +1,42 @@
+package logger
+
+import "time"
+
+// WithWatchdog calls fn with the length of the silence whenever nothing has been written to the log file for interval,
+// which usually means the application hangs. The watchdog stops when the logger is closed.
+func WithWatchdog(interval time.Duration, fn func(silence time.Duration)) Option {
+	return func(l *FileLogger) {
+		l.WatchdogInterval = interval
+		l.WatchdogFn = fn
+	}
+}
+
+func (l *FileLogger) startWatchdog() {
+	start := time.Now()
+	interval, fn := l.WatchdogInterval, l.WatchdogFn
+
+	l.goBackground(func(done <-chan struct{}) {
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-timer.C:
+				last := l.LastWriteTime()
+				if last.Before(start) {
+					last = start
+				}
+
+				silence := time.Since(last)
+				if silence >= interval {
+					fn(silence)
+					timer.Reset(interval)
+				} else {
+					timer.Reset(interval - silence)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWatchdogSilence(t *testing.T) {
+	calls := make(chan time.Duration, 10)
+	logger, err := newLogger(false, t.TempDir(), WithWatchdog(50*time.Millisecond, func(silence time.Duration) {
+		calls <- silence
+	}))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer logger.Close()
+
+	select {
+	case silence := <-calls:
+		if silence < 50*time.Millisecond {
+			t.Errorf("expected a silence of at least 50ms; got %s", silence)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the watchdog to fire")
+	}
+}
+
+func TestWatchdogWrites(t *testing.T) {
+	calls := make(chan time.Duration, 10)
+	logger, err := newLogger(false, t.TempDir(), WithWatchdog(50*time.Millisecond, func(silence time.Duration) {
+		calls <- silence
+	}))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+	defer logger.Close()
+
+	for i := 0; i < 15; i++ {
+		logger.LogDebug("tick")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if len(calls) != 0 {
+		t.Errorf("expected no watchdog calls while writing; got %d", len(calls))
+	}
+	if time.Since(logger.LastWriteTime()) > 50*time.Millisecond {
+		t.Errorf("expected a recent last write time; got %s", logger.LastWriteTime())
+	}
+}
+
+func TestWatchdogClose(t *testing.T) {
+	calls := make(chan time.Duration, 10)
+	logger, err := newLogger(false, t.TempDir(), WithWatchdog(20*time.Millisecond, func(silence time.Duration) {
+		calls <- silence
+	}))
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %s", err)
+	}
+	time.Sleep(60 * time.Millisecond)
+
+	if len(calls) != 0 {
+		t.Errorf("expected no watchdog calls after Close; got %d", len(calls))
+	}
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// LevelWriter is a writer that logs every complete line written to it at a fixed level.
+// Unlike the writer returned by WriterAt, it buffers partial writes until a newline arrives,
+// so a line written in several calls becomes a single entry. It is safe for concurrent use.
+type LevelWriter struct {
+	logger *FileLogger
+	level  LogLevel
+	// prefix is prepended to every line, and empty lines are skipped when it is set by PrefixedOutput.
+	prefix    string
+	skipEmpty bool
+
+	mu  sync.Mutex
+	buf []byte
+}
+
+// AsWriter returns a LevelWriter that logs the lines written to it at the given level.
+// Call Flush when done writing to log a trailing line that has no newline.
+func (l *FileLogger) AsWriter(level LogLevel) *LevelWriter {
+	return &LevelWriter{logger: l, level: level}
+}
+
+// PrefixedOutput returns a writer that logs the lines written to it at the given level with prefix prepended,
+// e.g. to tell apart the debug output of a third-party library. Partial writes are buffered until a newline
+// arrives like with AsWriter, and empty lines are skipped.
+func (l *FileLogger) PrefixedOutput(prefix string, level LogLevel) io.Writer {
+	return &LevelWriter{logger: l, level: level, prefix: prefix, skipEmpty: true}
+}
+
+func (w *LevelWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.logLine(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+	if len(w.buf) == 0 {
+		w.buf = nil
+	}
+	return len(p), nil
+}
+
+// Flush logs the pending partial line, if any, and returns its length in bytes.
+func (w *LevelWriter) Flush() (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n := len(w.buf)
+	if n > 0 {
+		w.logLine(w.buf)
+		w.buf = nil
+	}
+	return n, nil
+}
+
+func (w *LevelWriter) logLine(line []byte) {
+	if w.skipEmpty && len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+	w.logger.logAt(w.level, w.prefix+string(line))
+}
@@ -0,0 +1,117 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLevelWriter(t *testing.T) {
+	tests := []struct {
+		name     string
+		writes   []string
+		expected []string
+	}{
+		{name: "split line", writes: []string{"hello", " world\n"}, expected: []string{"hello world"}},
+		{name: "several lines in one write", writes: []string{"first\nsecond\n"}, expected: []string{"first", "second"}},
+		{name: "line across three writes", writes: []string{"a", "b", "c\nd\n"}, expected: []string{"abc", "d"}},
+		{name: "partial line is not logged", writes: []string{"done\npending"}, expected: []string{"done"}},
+		{name: "empty line", writes: []string{"\n"}, expected: []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureConsole(t)
+			logger := newTestLogger(t)
+			w := logger.AsWriter(LogLevelInfo)
+
+			for _, s := range tt.writes {
+				n, err := w.Write([]byte(s))
+				if err != nil || n != len(s) {
+					t.Fatalf("expected to write %d bytes; got %d, %v", len(s), n, err)
+				}
+			}
+
+			lines := strings.Split(strings.TrimSuffix(readLogFile(t, logger), "\n"), "\n")
+			if len(lines) != len(tt.expected) {
+				t.Fatalf("expected %d lines; got %q", len(tt.expected), lines)
+			}
+			for i, expected := range tt.expected {
+				if !strings.HasSuffix(lines[i], "INFO "+expected) {
+					t.Errorf("expected line %d to be %q; got %q", i, expected, lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLevelWriterFlush(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	w := logger.AsWriter(LogLevelWarn)
+
+	w.Write([]byte("no newline"))
+	n, err := w.Flush()
+	if err != nil || n != len("no newline") {
+		t.Fatalf("expected to flush %d bytes; got %d, %v", len("no newline"), n, err)
+	}
+	if n, _ := w.Flush(); n != 0 {
+		t.Errorf("expected nothing left to flush; got %d bytes", n)
+	}
+
+	content := readLogFile(t, logger)
+	if strings.Count(content, "\n") != 1 || !strings.Contains(content, "WARNING no newline") {
+		t.Errorf("expected the flushed line once; got %q", content)
+	}
+}
+
+func TestLevelWriterConcurrent(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	w := logger.AsWriter(LogLevelInfo)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				w.Write([]byte("complete line\n"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(readLogFile(t, logger), "\n"), "\n")
+	if len(lines) != 500 {
+		t.Fatalf("expected 500 lines; got %d", len(lines))
+	}
+	for _, line := range lines {
+		if !strings.HasSuffix(line, "INFO complete line") {
+			t.Fatalf("expected whole lines; got %q", line)
+		}
+	}
+}
+
+func TestPrefixedOutput(t *testing.T) {
+	captureConsole(t)
+	logger := newTestLogger(t)
+	w := logger.PrefixedOutput("[grpc] ", LogLevelDebug)
+
+	for _, s := range []string{"dialing ", "backend\n\n", "   \nconnected\nready", "\n"} {
+		if _, err := w.Write([]byte(s)); err != nil {
+			t.Fatalf("failed to write: %s", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(readLogFile(t, logger)), "\n")
+	expected := []string{"dialing backend", "connected", "ready"}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines; got %q", len(expected), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, "DEBUG [grpc] "+expected[i]) {
+			t.Errorf("expected line %d to be %q with the prefix; got %q", i, expected[i], line)
+		}
+	}
+}